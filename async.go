@@ -0,0 +1,175 @@
+package validationcontext
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// errAsyncTimeout is returned by asyncTask.run when the validator exceeded its timeout.
+var errAsyncTimeout = errors.New("validationcontext: async validator timed out")
+
+// asyncTask is a validator registered with Async.
+type asyncTask struct {
+	vc     *ValidationContext
+	ctx    context.Context
+	field  string
+	fn     func(ctx context.Context) error
+	frames []StackFrame
+}
+
+// WithAsyncConcurrency limits the number of async validators running at the same time.
+// A value of zero or less means no limit.
+func WithAsyncConcurrency(n int) Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.asyncConcurrency = n
+	}
+}
+
+// WithAsyncTimeout sets the timeout of each async validator.
+// A validator that exceeds it is reported with CodeTimeout.
+func WithAsyncTimeout(d time.Duration) Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.asyncTimeout = d
+	}
+}
+
+// Async registers a validator that needs a context.Context, e.g. a uniqueness
+// check against a repository. Validators run concurrently when Wait is called,
+// so that they only start once the synchronous rules have been applied; a
+// validator is skipped if its field already has an error.
+//
+// fn receives a context derived from ctx that is also cancelled when the ctx of
+// Wait is done. If fn returns a ValidationError (or a pointer to one), its Code,
+// Message and Params are recorded, an empty Message being resolved from the
+// catalog. Any other error is recorded with CodeAsync.
+func (vc *ValidationContext) Async(ctx context.Context, field string, fn func(ctx context.Context) error) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	task := asyncTask{
+		vc:     vc,
+		ctx:    ctx,
+		field:  field,
+		fn:     fn,
+		frames: captureFrames(vc.cfg.stackTrace),
+	}
+
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.asyncs = append(s.asyncs, task)
+}
+
+// runOrDefer registers fn with Async if ctx is set, and otherwise runs it at once
+// with the timeout of the context, so that a check that needs a context is
+// not silently lost when Wait is never called.
+func (vc *ValidationContext) runOrDefer(ctx context.Context, field string, fn func(ctx context.Context) error) {
+	if ctx != nil {
+		vc.Async(ctx, field, fn)
+		return
+	}
+	if vc.ShouldSkip(field) {
+		return
+	}
+	task := asyncTask{
+		vc:     vc,
+		ctx:    context.Background(),
+		field:  field,
+		fn:     fn,
+		frames: captureFrames(vc.cfg.stackTrace),
+	}
+	task.record(task.run(context.Background(), vc.cfg.asyncTimeout), vc.cfg.asyncTimeout)
+}
+
+// runAsync runs the tasks with the concurrency limit of the context and
+// records their errors in order.
+func (vc *ValidationContext) runAsync(ctx context.Context, tasks []asyncTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	var sem chan struct{}
+	if vc.cfg.asyncConcurrency > 0 {
+		sem = make(chan struct{}, vc.cfg.asyncConcurrency)
+	}
+	results := make([]chan error, len(tasks))
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+launch:
+	for i, task := range tasks {
+		if task.vc.ShouldSkip(task.field) || task.vc.HasFieldError(task.vc.fieldPath(task.field).String()) {
+			continue
+		}
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				break launch
+			}
+		}
+		results[i] = make(chan error, 1)
+		wg.Add(1)
+		go func(task asyncTask, result chan<- error) {
+			defer wg.Done()
+			if sem != nil {
+				defer func() { <-sem }()
+			}
+			result <- task.run(ctx, vc.cfg.asyncTimeout)
+		}(task, results[i])
+	}
+
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+		select {
+		case err := <-result:
+			tasks[i].record(err, vc.cfg.asyncTimeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return ctx.Err()
+}
+
+// run calls the validator with a context cancelled by waitCtx and bounded by timeout.
+func (t asyncTask) run(waitCtx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithCancel(t.ctx)
+	defer cancel()
+	stop := context.AfterFunc(waitCtx, cancel)
+	defer stop()
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
+
+	err := t.fn(ctx)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && waitCtx.Err() == nil {
+		return errAsyncTimeout
+	}
+	return err
+}
+
+// record adds the error returned by the validator to the context.
+func (t asyncTask) record(err error, timeout time.Duration) {
+	var validationErr ValidationError
+	var validationErrPtr *ValidationError
+	switch {
+	case err == nil:
+	case errors.Is(err, errAsyncTimeout):
+		t.vc.addError(t.field, CodeTimeout, "", map[string]interface{}{"timeout": timeout}, t.frames)
+	case errors.As(err, &validationErrPtr):
+		t.vc.addError(t.field, validationErrPtr.Code, validationErrPtr.Message, validationErrPtr.Params, t.frames)
+	case errors.As(err, &validationErr):
+		t.vc.addError(t.field, validationErr.Code, validationErr.Message, validationErr.Params, t.frames)
+	default:
+		t.vc.addError(t.field, CodeAsync, "", map[string]interface{}{"error": err.Error()}, t.frames)
+	}
+}
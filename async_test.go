@@ -0,0 +1,185 @@
+package validationcontext
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAsync(t *testing.T) {
+	errTaken := errors.New("taken")
+	tests := []struct {
+		name      string
+		fn        func(ctx context.Context) error
+		wantCode  string
+		wantParam map[string]interface{}
+	}{
+		{"Valid", func(ctx context.Context) error { return nil }, "", nil},
+		{"Error", func(ctx context.Context) error { return errTaken }, CodeAsync, map[string]interface{}{"error": "taken"}},
+		{"ValidationError", func(ctx context.Context) error {
+			return ValidationError{Code: "unique", Message: "Email is already taken"}
+		}, "unique", nil},
+		{"ValidationErrorPointer", func(ctx context.Context) error {
+			return &ValidationError{Code: CodeRequired}
+		}, CodeRequired, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.Async(context.Background(), "Email", tt.fn)
+			if vc.HasErrors() {
+				t.Fatalf("Expected async validator to run on Wait")
+			}
+			if err := vc.Wait(context.Background()); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("Expected 1 error, got: %v", len(errs))
+			}
+			if errs[0].Code != tt.wantCode {
+				t.Errorf("Expected code: %v, got: %v", tt.wantCode, errs[0].Code)
+			}
+			if errs[0].Message == "" {
+				t.Errorf("Expected message to be resolved")
+			}
+			for k, v := range tt.wantParam {
+				if errs[0].Params[k] != v {
+					t.Errorf("Expected param %v: %v, got: %v", k, v, errs[0].Params[k])
+				}
+			}
+		})
+	}
+}
+
+func TestAsyncOrder(t *testing.T) {
+	vc := NewValidationContext()
+	for i, field := range []string{"A", "B", "C"} {
+		delay := time.Duration(3-i) * 10 * time.Millisecond
+		vc.Async(context.Background(), field, func(ctx context.Context) error {
+			time.Sleep(delay)
+			return errors.New("invalid")
+		})
+	}
+	if err := vc.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	errs := vc.Errors()
+	if len(errs) != 3 {
+		t.Fatalf("Expected 3 errors, got: %v", len(errs))
+	}
+	for i, field := range []string{"A", "B", "C"} {
+		if errs[i].Field != field {
+			t.Errorf("Expected field: %v, got: %v", field, errs[i].Field)
+		}
+	}
+}
+
+func TestAsyncConcurrency(t *testing.T) {
+	vc := NewValidationContext(WithAsyncConcurrency(2))
+	var running, peak int32
+	for i := 0; i < 6; i++ {
+		vc.Async(context.Background(), "Field", func(ctx context.Context) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+	if err := vc.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent validators, got: %v", peak)
+	}
+}
+
+func TestAsyncTimeout(t *testing.T) {
+	vc := NewValidationContext(WithAsyncTimeout(10*time.Millisecond), WithLocale(LocaleEn))
+	vc.Async(context.Background(), "Email", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err := vc.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Code != CodeTimeout {
+		t.Fatalf("Expected a timeout error, got: %v", errs)
+	}
+	if !errors.Is(&errs[0], ErrTimeout) {
+		t.Errorf("Expected errors.Is(err, ErrTimeout)")
+	}
+	if errs[0].Message != "Validation of Email timed out." {
+		t.Errorf("Unexpected message: %v", errs[0].Message)
+	}
+}
+
+func TestAsyncCancel(t *testing.T) {
+	vc := NewValidationContext()
+	vc.Async(context.Background(), "Email", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := vc.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got: %v", err)
+	}
+	if vc.HasErrors() {
+		t.Errorf("Expected no errors for a cancelled Wait, got: %v", vc.Errors())
+	}
+}
+
+func TestAsyncSkipsFailedField(t *testing.T) {
+	vc := NewValidationContext()
+	called := false
+	vc.ValidateEmail("invalid", "Email", "")
+	vc.Async(context.Background(), "Email", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	if err := vc.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if called {
+		t.Errorf("Expected async validator to be skipped for a field with errors")
+	}
+}
+
+func TestAsyncInGo(t *testing.T) {
+	vc := NewValidationContext()
+	user := vc.Scope("user")
+	user.Go(func(vc *ValidationContext) {
+		vc.Async(context.Background(), "email", func(ctx context.Context) error {
+			return errors.New("taken")
+		})
+	})
+	if err := vc.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Field != "user.email" {
+		t.Fatalf("Expected error on user.email, got: %v", errs)
+	}
+}
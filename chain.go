@@ -0,0 +1,433 @@
+package validationcontext
+
+import (
+	"os"
+	"reflect"
+	"time"
+)
+
+// chainState tracks the failures of a rule chain.
+type chainState struct {
+	bail   bool
+	failed bool
+}
+
+// chainContext returns a view of vc that records the failures of a rule chain.
+func (vc *ValidationContext) chainContext() *ValidationContext {
+	cv := vc.view(vc.path)
+	cv.chain = &chainState{}
+	return cv
+}
+
+// StringRules is a fluent chain of rules for a string field, created by Field.
+// Each rule reuses the corresponding validator, so codes and messages are identical.
+// A chain is not safe for concurrent use.
+type StringRules struct {
+	vc    *ValidationContext
+	field string
+	value string
+}
+
+// Field starts a rule chain for a string field, e.g.
+//
+//	vc.Field("Password", pw).Required().MinLength(8).ContainsUpper().ContainsNumber()
+func (vc *ValidationContext) Field(field, value string) *StringRules {
+	return &StringRules{vc: vc.chainContext(), field: field, value: value}
+}
+
+// Bail stops the chain at its first failure: the following rules are skipped.
+func (r *StringRules) Bail() *StringRules {
+	r.vc.chain.bail = true
+	return r
+}
+
+// Required applies Required to the value.
+func (r *StringRules) Required() *StringRules {
+	r.vc.Required(r.value, r.field, "", false)
+	return r
+}
+
+// MinLength applies ValidateMinLength to the value.
+func (r *StringRules) MinLength(min int) *StringRules {
+	r.vc.ValidateMinLength(r.value, r.field, min, "")
+	return r
+}
+
+// MaxLength applies ValidateMaxLength to the value.
+func (r *StringRules) MaxLength(max int) *StringRules {
+	r.vc.ValidateMaxLength(r.value, r.field, max, "")
+	return r
+}
+
+// Email applies ValidateEmail to the value.
+func (r *StringRules) Email() *StringRules {
+	r.vc.ValidateEmail(r.value, r.field, "")
+	return r
+}
+
+// URL applies ValidateURL to the value.
+func (r *StringRules) URL() *StringRules {
+	r.vc.ValidateURL(r.value, r.field, "")
+	return r
+}
+
+// UUID applies ValidateUUID to the value.
+func (r *StringRules) UUID() *StringRules {
+	r.vc.ValidateUUID(r.value, r.field, "")
+	return r
+}
+
+// ContainsSpecial applies ValidateContainsSpecial to the value.
+func (r *StringRules) ContainsSpecial() *StringRules {
+	r.vc.ValidateContainsSpecial(r.value, r.field, "")
+	return r
+}
+
+// ContainsNumber applies ValidateContainsNumber to the value.
+func (r *StringRules) ContainsNumber() *StringRules {
+	r.vc.ValidateContainsNumber(r.value, r.field, "")
+	return r
+}
+
+// ContainsUpper applies ValidateContainsUppercase to the value.
+func (r *StringRules) ContainsUpper() *StringRules {
+	r.vc.ValidateContainsUppercase(r.value, r.field, "")
+	return r
+}
+
+// ContainsLower applies ValidateContainsLowercase to the value.
+func (r *StringRules) ContainsLower() *StringRules {
+	r.vc.ValidateContainsLowercase(r.value, r.field, "")
+	return r
+}
+
+// Date applies ValidateDate to the value.
+func (r *StringRules) Date() *StringRules {
+	r.vc.ValidateDate(r.value, r.field, "")
+	return r
+}
+
+// YearMonth applies ValidateYearMonth to the value.
+func (r *StringRules) YearMonth() *StringRules {
+	r.vc.ValidateYearMonth(r.value, r.field, "")
+	return r
+}
+
+// Year applies ValidateYear to the value.
+func (r *StringRules) Year() *StringRules {
+	r.vc.ValidateYear(r.value, r.field, "")
+	return r
+}
+
+// Month applies ValidateMonth to the value.
+func (r *StringRules) Month() *StringRules {
+	r.vc.ValidateMonth(r.value, r.field, "")
+	return r
+}
+
+// DateTime applies ValidateDateTime to the value.
+func (r *StringRules) DateTime() *StringRules {
+	r.vc.ValidateDateTime(r.value, r.field, "")
+	return r
+}
+
+// Time applies ValidateTime to the value.
+func (r *StringRules) Time() *StringRules {
+	r.vc.ValidateTime(r.value, r.field, "")
+	return r
+}
+
+// FilePath applies ValidateFilePath to the value.
+func (r *StringRules) FilePath() *StringRules {
+	r.vc.ValidateFilePath(r.value, r.field, "")
+	return r
+}
+
+// Path applies ValidatePath to the value.
+func (r *StringRules) Path(constraints PathConstraints) *StringRules {
+	r.vc.ValidatePath(r.value, r.field, constraints, "")
+	return r
+}
+
+// Rule applies the rule registered under name to the value, see ValidateRule.
+func (r *StringRules) Rule(name string, args ...interface{}) *StringRules {
+	r.vc.ValidateRule(r.value, r.field, name, args...)
+	return r
+}
+
+// NumberRules is a fluent chain of rules for a numeric field, created by NumberField.
+type NumberRules[T Number] struct {
+	vc    *ValidationContext
+	field string
+	value T
+}
+
+// NumberField starts a rule chain for a numeric field, e.g.
+//
+//	validationcontext.NumberField(vc, "Price", price).Positive().Max(10000)
+func NumberField[T Number](vc *ValidationContext, field string, value T) *NumberRules[T] {
+	return &NumberRules[T]{vc: vc.chainContext(), field: field, value: value}
+}
+
+// Bail stops the chain at its first failure: the following rules are skipped.
+func (r *NumberRules[T]) Bail() *NumberRules[T] {
+	r.vc.chain.bail = true
+	return r
+}
+
+// Required applies Required to the value, which fails for zero.
+func (r *NumberRules[T]) Required() *NumberRules[T] {
+	r.vc.Required(r.value, r.field, "", false)
+	return r
+}
+
+// Min applies ValidateMin to the value.
+func (r *NumberRules[T]) Min(min T) *NumberRules[T] {
+	ValidateMin(r.vc, r.value, r.field, min, "")
+	return r
+}
+
+// Max applies ValidateMax to the value.
+func (r *NumberRules[T]) Max(max T) *NumberRules[T] {
+	ValidateMax(r.vc, r.value, r.field, max, "")
+	return r
+}
+
+// Between applies ValidateBetween to the value.
+func (r *NumberRules[T]) Between(min, max T) *NumberRules[T] {
+	ValidateBetween(r.vc, r.value, r.field, min, max, "")
+	return r
+}
+
+// BetweenExclusive applies ValidateBetweenExclusive to the value.
+func (r *NumberRules[T]) BetweenExclusive(min, max T) *NumberRules[T] {
+	ValidateBetweenExclusive(r.vc, r.value, r.field, min, max, "")
+	return r
+}
+
+// Positive applies ValidatePositive to the value.
+func (r *NumberRules[T]) Positive() *NumberRules[T] {
+	ValidatePositive(r.vc, r.value, r.field, "")
+	return r
+}
+
+// Negative applies ValidateNegative to the value.
+func (r *NumberRules[T]) Negative() *NumberRules[T] {
+	ValidateNegative(r.vc, r.value, r.field, "")
+	return r
+}
+
+// NonZero applies ValidateNonZero to the value.
+func (r *NumberRules[T]) NonZero() *NumberRules[T] {
+	ValidateNonZero(r.vc, r.value, r.field, "")
+	return r
+}
+
+// MultipleOf applies ValidateMultipleOf to the value.
+func (r *NumberRules[T]) MultipleOf(step T) *NumberRules[T] {
+	ValidateMultipleOf(r.vc, r.value, r.field, step, "")
+	return r
+}
+
+// Finite applies ValidateFinite to floating-point values. Integers are always finite.
+func (r *NumberRules[T]) Finite() *NumberRules[T] {
+	if v := reflect.ValueOf(r.value); v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+		ValidateFinite(r.vc, v.Float(), r.field, "")
+	}
+	return r
+}
+
+// Rule applies the rule registered under name to the value, see ValidateRule.
+func (r *NumberRules[T]) Rule(name string, args ...interface{}) *NumberRules[T] {
+	r.vc.ValidateRule(r.value, r.field, name, args...)
+	return r
+}
+
+// TimeRules is a fluent chain of rules for a time.Time field, created by TimeField.
+type TimeRules struct {
+	vc    *ValidationContext
+	field string
+	value time.Time
+}
+
+// TimeField starts a rule chain for a time.Time field, e.g.
+//
+//	vc.TimeField("ExpiresAt", expiresAt).Required().After(time.Now())
+func (vc *ValidationContext) TimeField(field string, value time.Time) *TimeRules {
+	return &TimeRules{vc: vc.chainContext(), field: field, value: value}
+}
+
+// Bail stops the chain at its first failure: the following rules are skipped.
+func (r *TimeRules) Bail() *TimeRules {
+	r.vc.chain.bail = true
+	return r
+}
+
+// Required fails for the zero time.
+func (r *TimeRules) Required() *TimeRules {
+	r.vc.Required(r.value, r.field, "", false)
+	return r
+}
+
+// Before applies ValidateTimeBefore to the value.
+func (r *TimeRules) Before(limit time.Time) *TimeRules {
+	r.vc.ValidateTimeBefore(r.value, r.field, limit, "")
+	return r
+}
+
+// After applies ValidateTimeAfter to the value.
+func (r *TimeRules) After(limit time.Time) *TimeRules {
+	r.vc.ValidateTimeAfter(r.value, r.field, limit, "")
+	return r
+}
+
+// Rule applies the rule registered under name to the value, see ValidateRule.
+func (r *TimeRules) Rule(name string, args ...interface{}) *TimeRules {
+	r.vc.ValidateRule(r.value, r.field, name, args...)
+	return r
+}
+
+// FileRules is a fluent chain of rules for a file field, created by FileField.
+type FileRules struct {
+	vc    *ValidationContext
+	field string
+	file  *os.File
+}
+
+// FileField starts a rule chain for a file field, e.g.
+//
+//	vc.FileField("LicenseImage", file).Bail().Required().Extension(".png", ".jpg").MaxSize(2 * 1024 * 1024)
+//
+// The rules after Required are skipped for a nil file.
+func (vc *ValidationContext) FileField(field string, file *os.File) *FileRules {
+	return &FileRules{vc: vc.chainContext(), field: field, file: file}
+}
+
+// Bail stops the chain at its first failure: the following rules are skipped.
+func (r *FileRules) Bail() *FileRules {
+	r.vc.chain.bail = true
+	return r
+}
+
+// Required applies Required to the file.
+func (r *FileRules) Required() *FileRules {
+	r.vc.Required(r.file, r.field, "", false)
+	return r
+}
+
+// Extension applies ValidateFileExtension to the file.
+func (r *FileRules) Extension(extensions ...string) *FileRules {
+	if r.file != nil {
+		r.vc.ValidateFileExtension(r.file, r.field, extensions, "")
+	}
+	return r
+}
+
+// ContentType applies ValidateFileContentType to the file.
+func (r *FileRules) ContentType(allowedTypes ...string) *FileRules {
+	if r.file != nil {
+		r.vc.ValidateFileContentType(r.file, r.field, allowedTypes, "")
+	}
+	return r
+}
+
+// Image applies ValidateImage to the file.
+func (r *FileRules) Image(constraints ImageConstraints) *FileRules {
+	if r.file != nil {
+		r.vc.ValidateImage(r.file, r.field, constraints, "")
+	}
+	return r
+}
+
+// Archive applies ValidateArchive to the file.
+func (r *FileRules) Archive(constraints ArchiveConstraints) *FileRules {
+	if r.file != nil {
+		r.vc.ValidateArchive(r.file, r.field, constraints, "")
+	}
+	return r
+}
+
+// MaxSize applies ValidateFileSize to the file.
+func (r *FileRules) MaxSize(maxSize int64) *FileRules {
+	if r.file != nil {
+		r.vc.ValidateFileSize(r.file, r.field, maxSize, "")
+	}
+	return r
+}
+
+// Rule applies the rule registered under name to the file, see ValidateRule.
+func (r *FileRules) Rule(name string, args ...interface{}) *FileRules {
+	if r.file != nil {
+		r.vc.ValidateRule(r.file, r.field, name, args...)
+	}
+	return r
+}
+
+// UploadRules is a fluent chain of rules for an upload, created by UploadField.
+type UploadRules struct {
+	vc     *ValidationContext
+	field  string
+	upload *Upload
+}
+
+// UploadField starts a rule chain for an upload, e.g.
+//
+//	vc.UploadField("LicenseImage", validationcontext.FileHeaderUpload(header)).
+//		Bail().Required().MaxSize(2 * 1024 * 1024).ContentType("image/png", "image/jpeg")
+//
+// The rules after Required are skipped for a nil upload.
+func (vc *ValidationContext) UploadField(field string, upload *Upload) *UploadRules {
+	return &UploadRules{vc: vc.chainContext(), field: field, upload: upload}
+}
+
+// Bail stops the chain at its first failure: the following rules are skipped.
+func (r *UploadRules) Bail() *UploadRules {
+	r.vc.chain.bail = true
+	return r
+}
+
+// Required applies Required to the upload.
+func (r *UploadRules) Required() *UploadRules {
+	r.vc.Required(r.upload, r.field, "", false)
+	return r
+}
+
+// Extension applies ValidateUploadExtension to the upload.
+func (r *UploadRules) Extension(extensions ...string) *UploadRules {
+	if r.upload != nil {
+		r.vc.ValidateUploadExtension(r.upload, r.field, extensions, "")
+	}
+	return r
+}
+
+// MaxSize applies ValidateUploadSize to the upload.
+func (r *UploadRules) MaxSize(maxSize int64) *UploadRules {
+	if r.upload != nil {
+		r.vc.ValidateUploadSize(r.upload, r.field, maxSize, "")
+	}
+	return r
+}
+
+// ContentType applies ValidateUploadContentType to the upload.
+func (r *UploadRules) ContentType(allowedTypes ...string) *UploadRules {
+	if r.upload != nil {
+		r.vc.ValidateUploadContentType(r.upload, r.field, allowedTypes, "")
+	}
+	return r
+}
+
+// Archive applies ValidateUploadArchive to the upload.
+func (r *UploadRules) Archive(constraints ArchiveConstraints) *UploadRules {
+	if r.upload != nil {
+		r.vc.ValidateUploadArchive(r.upload, r.field, constraints, "")
+	}
+	return r
+}
+
+// Image applies ValidateUploadImage to the upload.
+func (r *UploadRules) Image(constraints ImageConstraints) *UploadRules {
+	if r.upload != nil {
+		r.vc.ValidateUploadImage(r.upload, r.field, constraints, "")
+	}
+	return r
+}
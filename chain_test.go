@@ -0,0 +1,174 @@
+package validationcontext
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStringRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		bail      bool
+		wantCodes []string
+	}{
+		{"Valid", "Passw0rd", false, nil},
+		{"AllFailures", "pass", false, []string{CodeMinLength, CodeContainsUpper, CodeContainsNumber}},
+		{"Bail", "pass", true, []string{CodeMinLength}},
+		{"EmptyWithBail", "", true, []string{CodeRequired}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			rules := vc.Field("Password", tt.value)
+			if tt.bail {
+				rules.Bail()
+			}
+			rules.Required().MinLength(8).ContainsUpper().ContainsNumber()
+
+			var codes []string
+			for _, err := range vc.Errors() {
+				codes = append(codes, err.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("Expected codes: %v, got: %v", tt.wantCodes, codes)
+			}
+		})
+	}
+}
+
+func TestStringRulesMessagesMatchValidators(t *testing.T) {
+	chained := NewValidationContext()
+	chained.Scope("user").Field("Email", "invalid").Email().MaxLength(3)
+
+	direct := NewValidationContext()
+	direct.Scope("user").ValidateEmail("invalid", "Email", "")
+	direct.Scope("user").ValidateMaxLength("invalid", "Email", 3, "")
+
+	got, want := chained.Errors(), direct.Errors()
+	if len(got) != len(want) {
+		t.Fatalf("Expected error count: %v, got: %v", len(want), len(got))
+	}
+	for i := range want {
+		if got[i].Field != want[i].Field || got[i].Code != want[i].Code || got[i].Message != want[i].Message {
+			t.Errorf("Expected: %v, got: %v", want[i], got[i])
+		}
+	}
+}
+
+func TestChainsAreIndependent(t *testing.T) {
+	vc := NewValidationContext()
+	vc.Field("Name", "").Bail().Required().MinLength(2)
+	vc.Field("Nickname", "").Required().MinLength(2)
+
+	if len(vc.Errors()) != 3 {
+		t.Errorf("Expected error count: 3, got: %v", len(vc.Errors()))
+	}
+}
+
+func TestNumberRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		validate  func(vc *ValidationContext)
+		wantCodes []string
+	}{
+		{"ValidFloat", func(vc *ValidationContext) {
+			NumberField(vc, "Price", 9.95).Positive().Max(100).MultipleOf(0.05).Finite()
+		}, nil},
+		{"InvalidInt64", func(vc *ValidationContext) { NumberField(vc, "ID", int64(-1)).NonZero().Positive().Min(1) }, []string{CodePositive, CodeMinValue}},
+		{"BailUint", func(vc *ValidationContext) { NumberField(vc, "Count", uint(0)).Bail().Required().Between(1, 10) }, []string{CodeRequired}},
+		{"Duration", func(vc *ValidationContext) {
+			NumberField(vc, "Timeout", 90*time.Second).BetweenExclusive(0, time.Minute).MultipleOf(time.Minute)
+		}, []string{CodeBetweenExclusive, CodeMultipleOf}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			tt.validate(vc)
+
+			var codes []string
+			for _, err := range vc.Errors() {
+				codes = append(codes, err.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("Expected codes: %v, got: %v", tt.wantCodes, codes)
+			}
+		})
+	}
+}
+
+func TestTimeRules(t *testing.T) {
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		value     time.Time
+		wantCodes []string
+	}{
+		{"Within", time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), nil},
+		{"BeforeStart", start, []string{CodeAfter}},
+		{"AfterEnd", time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), []string{CodeBefore}},
+		{"Zero", time.Time{}, []string{CodeRequired}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.TimeField("ReservedAt", tt.value).Bail().Required().After(start).Before(end)
+
+			var codes []string
+			for _, err := range vc.Errors() {
+				codes = append(codes, err.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("Expected codes: %v, got: %v", tt.wantCodes, codes)
+			}
+		})
+	}
+
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.TimeField("ReservedAt", end).Before(end)
+	if want := "ReservedAt must be before 2024-04-30 00:00:00."; vc.Errors()[0].Message != want {
+		t.Errorf("Expected message: %v, got: %v", want, vc.Errors()[0].Message)
+	}
+}
+
+func TestFileRules(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "testfile*.txt")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(make([]byte, 10)); err != nil {
+		t.Fatalf("Failed to write to temporary file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		file      *os.File
+		wantCodes []string
+	}{
+		{"Invalid", tmpFile, []string{CodeFileExtension, CodeFileSize}},
+		{"Nil", nil, []string{CodeRequired}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.FileField("LicenseImage", tt.file).Required().Extension(".png", ".jpg").MaxSize(5)
+
+			var codes []string
+			for _, err := range vc.Errors() {
+				codes = append(codes, err.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("Expected codes: %v, got: %v", tt.wantCodes, codes)
+			}
+		})
+	}
+}
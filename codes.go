@@ -0,0 +1,87 @@
+package validationcontext
+
+// Error codes identify which rule produced a ValidationError.
+// They are stable and intended for machine consumption, e.g. for clients
+// that re-render or translate validation errors themselves.
+const (
+	CodeCustom                  = "custom"
+	CodeRequired                = "required"
+	CodeRequiredIf              = "required_if"
+	CodeRequiredUnless          = "required_unless"
+	CodeRequiredWith            = "required_with"
+	CodeRequiredWithout         = "required_without"
+	CodeMinLength               = "min_length"
+	CodeMaxLength               = "max_length"
+	CodeMinItems                = "min_items"
+	CodeMaxItems                = "max_items"
+	CodeEmail                   = "email"
+	CodeEmailLength             = "email_length"
+	CodeEmailDisplayName        = "email_display_name"
+	CodeEmailBlockedDomain      = "email_blocked_domain"
+	CodeEmailDomain             = "email_domain"
+	CodeContainsSpecial         = "contains_special"
+	CodeContainsNumber          = "contains_number"
+	CodeContainsUpper           = "contains_uppercase"
+	CodeContainsLower           = "contains_lowercase"
+	CodeURL                     = "url"
+	CodeURLScheme               = "url_scheme"
+	CodeURLPort                 = "url_port"
+	CodeURLLength               = "url_length"
+	CodeURLUserInfo             = "url_userinfo"
+	CodeURLPrivateIP            = "url_private_ip"
+	CodeUUID                    = "uuid"
+	CodeMinValue                = "min_value"
+	CodeMaxValue                = "max_value"
+	CodeBetween                 = "between"
+	CodeBetweenExclusive        = "between_exclusive"
+	CodePositive                = "positive"
+	CodeNegative                = "negative"
+	CodeNonZero                 = "non_zero"
+	CodeMultipleOf              = "multiple_of"
+	CodeFinite                  = "finite"
+	CodeDate                    = "date"
+	CodeYearMonth               = "year_month"
+	CodeYear                    = "year"
+	CodeMonth                   = "month"
+	CodeDateTime                = "datetime"
+	CodeTime                    = "time"
+	CodeBefore                  = "before"
+	CodeAfter                   = "after"
+	CodeEqualField              = "eq_field"
+	CodeNotEqualField           = "ne_field"
+	CodeGreaterThanField        = "gt_field"
+	CodeGreaterThanOrEqualField = "gte_field"
+	CodeLessThanField           = "lt_field"
+	CodeLessThanOrEqualField    = "lte_field"
+	CodeAfterField              = "after_field"
+	CodeBeforeField             = "before_field"
+	CodeFilePath                = "file_path"
+	CodeFileExtension           = "file_extension"
+	CodeFileSize                = "file_size"
+	CodeFileStat                = "file_stat"
+	CodePathNotExist            = "path_not_exist"
+	CodePathPermission          = "path_permission"
+	CodePathOutsideBase         = "path_outside_base"
+	CodePathIsDirectory         = "path_is_directory"
+	CodePathNotRegular          = "path_not_regular"
+	CodePathNotDirectory        = "path_not_directory"
+	CodePathNotReadable         = "path_not_readable"
+	CodePathNotWritable         = "path_not_writable"
+	CodeFileRead                = "file_read"
+	CodeFileContentType         = "file_content_type"
+	CodeFileExtensionMismatch   = "file_extension_mismatch"
+	CodeImageDecode             = "image_decode"
+	CodeImagePixels             = "image_pixels"
+	CodeImageTooSmall           = "image_too_small"
+	CodeImageTooLarge           = "image_too_large"
+	CodeImageAspectRatio        = "image_aspect_ratio"
+	CodeArchiveFormat           = "archive_format"
+	CodeArchiveEntries          = "archive_entries"
+	CodeArchiveSize             = "archive_size"
+	CodeArchiveRatio            = "archive_compression_ratio"
+	CodeArchiveDepth            = "archive_depth"
+	CodeArchivePath             = "archive_path"
+	CodeArchiveExtension        = "archive_extension"
+	CodeAsync                   = "async"
+	CodeTimeout                 = "timeout"
+)
@@ -0,0 +1,62 @@
+package validationcontext
+
+import (
+	"context"
+)
+
+// Go runs fn in a new goroutine with its own context that shares the options
+// and field path of vc. The errors it collects are merged into vc by Wait, in the
+// order the Go calls were made, so the result does not depend on scheduling.
+// If vc has already reached the limit of WithFailFast or WithMaxErrors, fn is not run.
+func (vc *ValidationContext) Go(fn func(vc *ValidationContext)) {
+	child := &ValidationContext{
+		errors:   make([]ValidationError, 0),
+		cfg:      vc.cfg,
+		path:     vc.path,
+		severity: vc.severity,
+	}
+
+	s := vc.store()
+	s.mu.Lock()
+	if s.limitReachedLocked() {
+		s.mu.Unlock()
+		return
+	}
+	s.pending = append(s.pending, child)
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		fn(child)
+	}()
+}
+
+// Wait blocks until all functions started with Go have returned and merges
+// their errors into vc. It then runs the validators registered with Async and
+// records their errors in registration order.
+// It returns ctx.Err() if ctx is done before the async validators complete.
+func (vc *ValidationContext) Wait(ctx context.Context) error {
+	s := vc.store()
+	s.wg.Wait()
+
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = nil
+	tasks := s.asyncs
+	s.asyncs = nil
+	s.mu.Unlock()
+
+	var waitErr error
+	for _, child := range pending {
+		if err := child.Wait(ctx); err != nil && waitErr == nil {
+			waitErr = err
+		}
+		s.merge(child.Issues(), child.Truncated())
+	}
+
+	if err := s.runAsync(ctx, tasks); err != nil && waitErr == nil {
+		waitErr = err
+	}
+	return waitErr
+}
@@ -0,0 +1,123 @@
+package validationcontext
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+)
+
+// validateAllInvalid runs every built-in validator with an invalid value and
+// returns the number of errors it is expected to add.
+func validateAllInvalid(vc *ValidationContext, file *os.File) int {
+	vc.Required("", "Required", "", false)
+	vc.ValidateMinLength("a", "MinLength", 2, "")
+	vc.ValidateMaxLength("abc", "MaxLength", 2, "")
+	vc.ValidateEmail("invalid", "Email", "")
+	vc.ValidateContainsSpecial("abc", "Special", "")
+	vc.ValidateContainsSpecialRegx("abc", "SpecialRegx", "")
+	vc.ValidateContainsNumber("abc", "Number", "")
+	vc.ValidateContainsNumberRegx("abc", "NumberRegx", "")
+	vc.ValidateContainsUppercase("abc", "Uppercase", "")
+	vc.ValidateContainsLowercase("ABC", "Lowercase", "")
+	vc.ValidateURL("invalid", "URL", "")
+	vc.ValidateFile("nonexistentfile.txt", "File", "")
+	vc.ValidateUUID("invalid", "UUID", "")
+	vc.ValidateMinValue(1, "MinValue", 2, "")
+	vc.ValidateMaxValue(3, "MaxValue", 2, "")
+	vc.ValidateDate("2023-13-01", "Date", "")
+	vc.ValidateYearMonth("2023-13", "YearMonth", "")
+	vc.ValidateYear("20A3", "Year", "")
+	vc.ValidateMonth("13", "Month", "")
+	vc.ValidateDateTime("2023-07-25 25:04:05", "DateTime", "")
+	vc.ValidateTime("25:04", "Time", "")
+	vc.ValidateFilePath("nonexistentfile.txt", "FilePath", "")
+	vc.ValidateFileExtension(file, "FileExtension", []string{".png"}, "")
+	vc.ValidateFileSize(file, "FileSize", 0, "")
+	return 24
+}
+
+func createTempTextFile(t *testing.T) *os.File {
+	t.Helper()
+	tmpFile, err := os.CreateTemp("", "testfile*.txt")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	t.Cleanup(func() {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+	})
+	if _, err := tmpFile.Write([]byte("content")); err != nil {
+		t.Fatalf("Failed to write to temporary file: %v", err)
+	}
+	return tmpFile
+}
+
+func TestConcurrentValidation(t *testing.T) {
+	tmpFile := createTempTextFile(t)
+
+	const goroutines = 16
+	vc := NewValidationContext()
+	var wg sync.WaitGroup
+	counts := make([]int, goroutines)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			counts[i] = validateAllInvalid(vc, tmpFile)
+			_ = vc.HasErrors()
+			_ = vc.FormatErrors()
+		}(i)
+	}
+	wg.Wait()
+
+	expected := 0
+	for _, n := range counts {
+		expected += n
+	}
+	if len(vc.Errors()) != expected {
+		t.Errorf("Expected error count: %v, got: %v", expected, len(vc.Errors()))
+	}
+}
+
+func TestGoWait(t *testing.T) {
+	tmpFile := createTempTextFile(t)
+
+	const goroutines = 8
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.AddError("Before", "Error")
+	for i := 0; i < goroutines; i++ {
+		field := fmt.Sprintf("Field%d", i)
+		vc.Go(func(vc *ValidationContext) {
+			vc.Required("", field, "", false)
+			vc.Go(func(vc *ValidationContext) {
+				vc.ValidateMinLength("", field, 1, "")
+			})
+			validateAllInvalid(vc, tmpFile)
+		})
+	}
+	vc.Wait(context.Background())
+
+	errs := vc.Errors()
+	perGoroutine := 1 + 1 + validateAllInvalid(NewValidationContext(), tmpFile)
+	if len(errs) != 1+goroutines*perGoroutine {
+		t.Fatalf("Expected error count: %v, got: %v", 1+goroutines*perGoroutine, len(errs))
+	}
+	if errs[0].Field != "Before" {
+		t.Errorf("Expected first error for Before, got: %v", errs[0].Field)
+	}
+	for i := 0; i < goroutines; i++ {
+		first := errs[1+i*perGoroutine]
+		if want := fmt.Sprintf("Field%d", i); first.Field != want || first.Code != CodeRequired {
+			t.Errorf("Expected %s error for %s at goroutine %d, got: %s %s", CodeRequired, want, i, first.Code, first.Field)
+		}
+		if first.Message != fmt.Sprintf("Field%d is required.", i) {
+			t.Errorf("Expected locale to be inherited, got: %v", first.Message)
+		}
+		last := errs[(i+1)*perGoroutine]
+		if last.Code != CodeMinLength {
+			t.Errorf("Expected nested Go errors last at goroutine %d, got: %v", i, last.Code)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package validationcontext
+
+import (
+	"fmt"
+)
+
+// RuleError identifies a validation rule by its code.
+// It is the type of the sentinel errors below: errors.Is reports whether a
+// ValidationError, or any error of a ValidationAggregateError, was produced by
+// the rule, e.g. errors.Is(err, validationcontext.ErrRequired).
+// Custom rules can be matched with &RuleError{Code: "employee_code"}.
+type RuleError struct {
+	Code string
+}
+
+// Error implements the error interface for RuleError.
+func (e *RuleError) Error() string {
+	return "validationcontext: " + e.Code
+}
+
+// Sentinel errors for the built-in rules.
+var (
+	ErrCustom                     = &RuleError{Code: CodeCustom}
+	ErrRequired                   = &RuleError{Code: CodeRequired}
+	ErrRequiredIf                 = &RuleError{Code: CodeRequiredIf}
+	ErrRequiredUnless             = &RuleError{Code: CodeRequiredUnless}
+	ErrRequiredWith               = &RuleError{Code: CodeRequiredWith}
+	ErrRequiredWithout            = &RuleError{Code: CodeRequiredWithout}
+	ErrMinLength                  = &RuleError{Code: CodeMinLength}
+	ErrMaxLength                  = &RuleError{Code: CodeMaxLength}
+	ErrMinItems                   = &RuleError{Code: CodeMinItems}
+	ErrMaxItems                   = &RuleError{Code: CodeMaxItems}
+	ErrInvalidEmail               = &RuleError{Code: CodeEmail}
+	ErrEmailTooLong               = &RuleError{Code: CodeEmailLength}
+	ErrEmailDisplayName           = &RuleError{Code: CodeEmailDisplayName}
+	ErrEmailBlockedDomain         = &RuleError{Code: CodeEmailBlockedDomain}
+	ErrEmailDomain                = &RuleError{Code: CodeEmailDomain}
+	ErrContainsSpecial            = &RuleError{Code: CodeContainsSpecial}
+	ErrContainsNumber             = &RuleError{Code: CodeContainsNumber}
+	ErrContainsUpper              = &RuleError{Code: CodeContainsUpper}
+	ErrContainsLower              = &RuleError{Code: CodeContainsLower}
+	ErrInvalidURL                 = &RuleError{Code: CodeURL}
+	ErrURLScheme                  = &RuleError{Code: CodeURLScheme}
+	ErrURLPort                    = &RuleError{Code: CodeURLPort}
+	ErrURLTooLong                 = &RuleError{Code: CodeURLLength}
+	ErrURLUserInfo                = &RuleError{Code: CodeURLUserInfo}
+	ErrURLPrivateIP               = &RuleError{Code: CodeURLPrivateIP}
+	ErrInvalidUUID                = &RuleError{Code: CodeUUID}
+	ErrMinValue                   = &RuleError{Code: CodeMinValue}
+	ErrMaxValue                   = &RuleError{Code: CodeMaxValue}
+	ErrBetween                    = &RuleError{Code: CodeBetween}
+	ErrBetweenExclusive           = &RuleError{Code: CodeBetweenExclusive}
+	ErrNotPositive                = &RuleError{Code: CodePositive}
+	ErrNotNegative                = &RuleError{Code: CodeNegative}
+	ErrZero                       = &RuleError{Code: CodeNonZero}
+	ErrNotMultipleOf              = &RuleError{Code: CodeMultipleOf}
+	ErrNotFinite                  = &RuleError{Code: CodeFinite}
+	ErrInvalidDate                = &RuleError{Code: CodeDate}
+	ErrInvalidYearMonth           = &RuleError{Code: CodeYearMonth}
+	ErrInvalidYear                = &RuleError{Code: CodeYear}
+	ErrInvalidMonth               = &RuleError{Code: CodeMonth}
+	ErrInvalidDateTime            = &RuleError{Code: CodeDateTime}
+	ErrInvalidTime                = &RuleError{Code: CodeTime}
+	ErrNotBefore                  = &RuleError{Code: CodeBefore}
+	ErrNotAfter                   = &RuleError{Code: CodeAfter}
+	ErrNotEqualField              = &RuleError{Code: CodeEqualField}
+	ErrEqualField                 = &RuleError{Code: CodeNotEqualField}
+	ErrNotGreaterThanField        = &RuleError{Code: CodeGreaterThanField}
+	ErrNotGreaterThanOrEqualField = &RuleError{Code: CodeGreaterThanOrEqualField}
+	ErrNotLessThanField           = &RuleError{Code: CodeLessThanField}
+	ErrNotLessThanOrEqualField    = &RuleError{Code: CodeLessThanOrEqualField}
+	ErrNotAfterField              = &RuleError{Code: CodeAfterField}
+	ErrNotBeforeField             = &RuleError{Code: CodeBeforeField}
+	ErrInvalidFilePath            = &RuleError{Code: CodeFilePath}
+	ErrInvalidFileExtension       = &RuleError{Code: CodeFileExtension}
+	ErrFileTooLarge               = &RuleError{Code: CodeFileSize}
+	ErrFileStat                   = &RuleError{Code: CodeFileStat}
+	ErrPathNotExist               = &RuleError{Code: CodePathNotExist}
+	ErrPathPermission             = &RuleError{Code: CodePathPermission}
+	ErrPathOutsideBase            = &RuleError{Code: CodePathOutsideBase}
+	ErrPathIsDirectory            = &RuleError{Code: CodePathIsDirectory}
+	ErrPathNotRegular             = &RuleError{Code: CodePathNotRegular}
+	ErrPathNotDirectory           = &RuleError{Code: CodePathNotDirectory}
+	ErrPathNotReadable            = &RuleError{Code: CodePathNotReadable}
+	ErrPathNotWritable            = &RuleError{Code: CodePathNotWritable}
+	ErrFileRead                   = &RuleError{Code: CodeFileRead}
+	ErrFileContentType            = &RuleError{Code: CodeFileContentType}
+	ErrFileExtensionMismatch      = &RuleError{Code: CodeFileExtensionMismatch}
+	ErrInvalidImage               = &RuleError{Code: CodeImageDecode}
+	ErrImageTooManyPixels         = &RuleError{Code: CodeImagePixels}
+	ErrImageTooSmall              = &RuleError{Code: CodeImageTooSmall}
+	ErrImageTooLarge              = &RuleError{Code: CodeImageTooLarge}
+	ErrImageAspectRatio           = &RuleError{Code: CodeImageAspectRatio}
+	ErrInvalidArchive             = &RuleError{Code: CodeArchiveFormat}
+	ErrArchiveTooManyEntries      = &RuleError{Code: CodeArchiveEntries}
+	ErrArchiveTooLarge            = &RuleError{Code: CodeArchiveSize}
+	ErrArchiveCompressionRatio    = &RuleError{Code: CodeArchiveRatio}
+	ErrArchiveTooDeep             = &RuleError{Code: CodeArchiveDepth}
+	ErrArchiveUnsafePath          = &RuleError{Code: CodeArchivePath}
+	ErrArchiveInvalidExtension    = &RuleError{Code: CodeArchiveExtension}
+	ErrAsync                      = &RuleError{Code: CodeAsync}
+	ErrTimeout                    = &RuleError{Code: CodeTimeout}
+)
+
+// Error implements the error interface for ValidationError.
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("Field: %s, Error: %s", e.Field, e.Message)
+}
+
+// Is reports whether target is the RuleError of the rule that produced e.
+func (e ValidationError) Is(target error) bool {
+	t, ok := target.(*RuleError)
+	return ok && t.Code == e.Code
+}
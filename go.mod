@@ -2,4 +2,8 @@ module github.com/take0fit/validationcontext
 
 go 1.22.2
 
-require github.com/google/uuid v1.6.0 // indirect
+require (
+	github.com/google/uuid v1.6.0
+	golang.org/x/net v0.35.0
+	golang.org/x/text v0.22.0
+)
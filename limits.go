@@ -0,0 +1,82 @@
+package validationcontext
+
+// WithFailFast stops the validation after the first error: once the context
+// has an error, validators become no-ops and further errors are discarded.
+func WithFailFast() Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.failFast = true
+	}
+}
+
+// WithBail stops the validation of a field after its first error: validators
+// for a field that already has an error become no-ops.
+func WithBail() Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.bail = true
+	}
+}
+
+// WithMaxErrors caps the number of errors kept by the context at n. Once the
+// cap is reached, validators become no-ops, and errors added by other means
+// (AddError, Wait) are only counted and reported as "and N more".
+// A value of zero or less means no limit.
+func WithMaxErrors(n int) Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.maxErrors = n
+	}
+}
+
+// ShouldSkip reports whether a validator for field can be skipped because of
+// WithFailFast, WithBail, WithMaxErrors or a failed rule chain in Bail mode. Custom and costly validators can
+// call it to avoid unnecessary work.
+func (vc *ValidationContext) ShouldSkip(field string) bool {
+	if vc.chain != nil && vc.chain.bail && vc.chain.failed {
+		return true
+	}
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.limitReachedLocked() {
+		return true
+	}
+	return vc.cfg.bail && s.hasFieldErrorLocked(vc.fieldPath(field).String())
+}
+
+// Truncated returns the number of errors discarded by WithFailFast or WithMaxErrors.
+func (vc *ValidationContext) Truncated() int {
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.truncated
+}
+
+func (vc *ValidationContext) limitReachedLocked() bool {
+	n := vc.countLocked(SeverityError)
+	return (vc.cfg.failFast && n > 0) || (vc.cfg.maxErrors > 0 && n >= vc.cfg.maxErrors)
+}
+
+func (vc *ValidationContext) hasFieldErrorLocked(field string) bool {
+	for _, err := range vc.errors {
+		if err.Field == field && err.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// appendLocked stores err unless a limit has been reached.
+// Warnings and notices are not counted by the limits, but are discarded with
+// the errors once a limit has been reached; only errors count as truncated.
+// It must be called on the store with its mutex held.
+func (vc *ValidationContext) appendLocked(err ValidationError) {
+	if vc.limitReachedLocked() {
+		if err.Severity == SeverityError {
+			vc.truncated++
+		}
+		return
+	}
+	if vc.cfg.bail && vc.hasFieldErrorLocked(err.Field) {
+		return
+	}
+	vc.errors = append(vc.errors, err)
+}
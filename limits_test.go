@@ -0,0 +1,109 @@
+package validationcontext
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLimitOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []Option
+		wantFields    []string
+		wantTruncated int
+	}{
+		{"NoLimit", nil, []string{"Name", "Name", "Email", "Age"}, 0},
+		{"FailFast", []Option{WithFailFast()}, []string{"Name"}, 0},
+		{"Bail", []Option{WithBail()}, []string{"Name", "Email", "Age"}, 0},
+		{"MaxErrors", []Option{WithMaxErrors(2)}, []string{"Name", "Name"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext(tt.opts...)
+			vc.ValidateMinLength("a", "Name", 2, "")
+			vc.ValidateContainsNumber("a", "Name", "")
+			vc.ValidateEmail("invalid", "Email", "")
+			vc.ValidateMinValue(1, "Age", 18, "")
+
+			errs := vc.Errors()
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected error count: %v, got: %v", len(tt.wantFields), len(errs))
+			}
+			for i, field := range tt.wantFields {
+				if errs[i].Field != field {
+					t.Errorf("Expected field: %v, got: %v", field, errs[i].Field)
+				}
+			}
+			if vc.Truncated() != tt.wantTruncated {
+				t.Errorf("Expected truncated: %v, got: %v", tt.wantTruncated, vc.Truncated())
+			}
+		})
+	}
+}
+
+func TestFailFastSkipsValidators(t *testing.T) {
+	vc := NewValidationContext(WithFailFast())
+	vc.Required("", "Name", "", false)
+
+	// A closed file would add a stat error if the validator ran.
+	tmpFile, err := os.CreateTemp("", "testfile")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+	vc.ValidateFileSize(tmpFile, "File", 1, "")
+
+	ran := false
+	vc.Go(func(vc *ValidationContext) { ran = true })
+	vc.Wait(context.Background())
+
+	if ran {
+		t.Error("Expected Go to be skipped after the first error")
+	}
+	if !vc.ShouldSkip("Other") {
+		t.Error("Expected ShouldSkip to be true after the first error")
+	}
+	if len(vc.Errors()) != 1 || vc.Truncated() != 0 {
+		t.Errorf("Expected 1 error and nothing truncated, got: %v, %v", len(vc.Errors()), vc.Truncated())
+	}
+}
+
+func TestBailWithScopes(t *testing.T) {
+	vc := NewValidationContext(WithBail())
+	items := vc.Scope("items")
+	items.Index(0).ValidateMinLength("a", "name", 2, "")
+	items.Index(0).ValidateContainsNumber("a", "name", "")
+	items.Index(1).ValidateContainsNumber("a", "name", "")
+
+	if !items.Index(0).ShouldSkip("name") || items.Index(2).ShouldSkip("name") {
+		t.Error("Expected ShouldSkip to be scoped to the field path")
+	}
+	if len(vc.Errors()) != 2 {
+		t.Errorf("Expected error count: 2, got: %v", len(vc.Errors()))
+	}
+}
+
+func TestMaxErrorsSummary(t *testing.T) {
+	vc := NewValidationContext(WithMaxErrors(1))
+	vc.AddError("Field1", "Error1")
+	vc.AddError("Field2", "Error2")
+	vc.AddError("Field3", "Error3")
+
+	if vc.Truncated() != 2 {
+		t.Errorf("Expected truncated: 2, got: %v", vc.Truncated())
+	}
+
+	expected := "Validation errors:\nField: Field1, Error: Error1\n...and 2 more\n"
+	if got := vc.FormatErrors(); got != expected {
+		t.Errorf("FormatErrors() = %q, want %q", got, expected)
+	}
+
+	err := vc.AggregateError()
+	if !strings.HasSuffix(err.Error(), "Field: Field1, Error: Error1; ...and 2 more") {
+		t.Errorf("Unexpected aggregate error: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package validationcontext
+
+// Merge copies the errors of other into vc, prefixing their paths with the
+// path of vc and prefix, e.g. vc.Merge(addressVC, "address") reports the
+// "street" error of addressVC as "address.street". An empty prefix only adds
+// the path of vc. Codes, params, messages, severities and stack traces are kept, and the
+// limits of vc apply to the merged errors.
+//
+// Only the errors collected so far are merged: call Wait on other first if it
+// started goroutines or async validators.
+func (vc *ValidationContext) Merge(other *ValidationContext, prefix string) {
+	if other == nil {
+		return
+	}
+	path := vc.fieldPath(prefix)
+	errs := other.Issues()
+	for i := range errs {
+		errs[i].Path = append(append(Path{}, path...), errs[i].Path...)
+		errs[i].Field = errs[i].Path.String()
+	}
+	vc.store().merge(errs, other.Truncated())
+}
+
+// ChildContext is an independent context created by Child. Its errors are
+// added to the parent by Commit, or dropped by Discard.
+type ChildContext struct {
+	*ValidationContext
+	parent *ValidationContext
+	done   bool
+}
+
+// Child returns a context that shares the options and field path of vc but
+// collects its errors separately, e.g. to validate an aggregate and only keep
+// the result if it is used:
+//
+//	child := vc.Child()
+//	address, err := NewAddress(input, child.ValidationContext)
+//	if useAddress {
+//		child.Commit()
+//	} else {
+//		child.Discard()
+//	}
+func (vc *ValidationContext) Child() *ChildContext {
+	return &ChildContext{
+		ValidationContext: &ValidationContext{
+			errors:   make([]ValidationError, 0),
+			cfg:      vc.cfg,
+			path:     vc.path,
+			severity: vc.severity,
+		},
+		parent: vc,
+	}
+}
+
+// Commit adds the errors of the child to its parent. Calling Commit or
+// Discard again has no effect.
+func (c *ChildContext) Commit() {
+	if c.done {
+		return
+	}
+	c.done = true
+	c.parent.store().merge(c.Issues(), c.Truncated())
+}
+
+// Discard drops the errors of the child. Calling Commit or Discard again has no effect.
+func (c *ChildContext) Discard() {
+	c.done = true
+}
+
+// merge appends errs to the store, subject to its limits, and adds truncated
+// to the number of discarded errors.
+func (vc *ValidationContext) merge(errs []ValidationError, truncated int) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	for _, err := range errs {
+		vc.appendLocked(err)
+	}
+	vc.truncated += truncated
+}
@@ -0,0 +1,83 @@
+package validationcontext
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	address := NewValidationContext(WithLocale(LocaleEn))
+	address.Required("", "street", "", false)
+	address.Scope("geo").ValidateMinValue(-100, "lat", -90, "")
+
+	vc := NewValidationContext()
+	vc.Required("", "name", "", false)
+	vc.Merge(address, "address")
+	vc.Scope("order").Merge(address, "")
+	vc.Merge(nil, "address")
+
+	errs := vc.Errors()
+	wantFields := []string{"name", "address.street", "address.geo.lat", "order.street", "order.geo.lat"}
+	if len(errs) != len(wantFields) {
+		t.Fatalf("Expected error count: %v, got: %v", len(wantFields), len(errs))
+	}
+	for i, field := range wantFields {
+		if errs[i].Field != field {
+			t.Errorf("Expected field: %v, got: %v", field, errs[i].Field)
+		}
+	}
+
+	merged, original := errs[2], address.Errors()[1]
+	if merged.Code != CodeMinValue || merged.Message != original.Message || merged.StackTrace != original.StackTrace {
+		t.Errorf("Expected code, message and stack trace to be kept, got: %+v", merged)
+	}
+	if merged.Path.JSONPointer() != "/address/geo/lat" {
+		t.Errorf("Unexpected path: %v", merged.Path.JSONPointer())
+	}
+	if len(address.Errors()[1].Path) != 2 {
+		t.Errorf("Expected the merged context to be unchanged")
+	}
+}
+
+func TestMergeLimits(t *testing.T) {
+	other := NewValidationContext()
+	other.Required("", "a", "", false)
+	other.Required("", "b", "", false)
+
+	vc := NewValidationContext(WithMaxErrors(2))
+	vc.Required("", "c", "", false)
+	vc.Merge(other, "")
+
+	if len(vc.Errors()) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", len(vc.Errors()))
+	}
+	if vc.Truncated() != 1 {
+		t.Errorf("Expected truncated: 1, got: %v", vc.Truncated())
+	}
+}
+
+func TestChild(t *testing.T) {
+	vc := NewValidationContext()
+	items := vc.Scope("items").Index(0)
+
+	committed := items.Child()
+	committed.Required("", "qty", "", false)
+	if vc.HasErrors() {
+		t.Fatalf("Expected the child to collect errors separately")
+	}
+	committed.Commit()
+	committed.Commit()
+
+	discarded := items.Child()
+	discarded.Required("", "name", "", false)
+	discarded.Discard()
+	discarded.Commit()
+
+	errs := vc.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got: %v", errs)
+	}
+	if errs[0].Field != "items[0].qty" || !errors.Is(&errs[0], ErrRequired) {
+		t.Errorf("Unexpected error: %+v", errs[0])
+	}
+}
@@ -0,0 +1,135 @@
+package validationcontext
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Supported built-in locales.
+const (
+	LocaleJa = "ja"
+	LocaleEn = "en"
+
+	// DefaultLocale is used when a context has no locale or when a template
+	// is missing for the requested locale.
+	DefaultLocale = LocaleJa
+)
+
+// MessageCatalog resolves message templates by locale and error code.
+//
+// Templates may reference "{field}" and any key of the error params, e.g.
+// "{field}は{min}文字以上で入力してください。".
+type MessageCatalog interface {
+	Template(locale, code string) (string, bool)
+}
+
+// Catalog is an in-memory MessageCatalog keyed by locale and code.
+// It is safe for concurrent use.
+type Catalog struct {
+	mu        sync.RWMutex
+	templates map[string]map[string]string
+}
+
+// NewCatalog creates and returns an empty Catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{templates: make(map[string]map[string]string)}
+}
+
+// Register adds or overrides the template for the given locale and code.
+func (c *Catalog) Register(locale, code, template string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bundle, ok := c.templates[locale]
+	if !ok {
+		bundle = make(map[string]string)
+		c.templates[locale] = bundle
+	}
+	bundle[code] = template
+}
+
+// RegisterBundle adds or overrides all templates of a locale at once.
+func (c *Catalog) RegisterBundle(locale string, templates map[string]string) {
+	for code, template := range templates {
+		c.Register(locale, code, template)
+	}
+}
+
+// Template returns the template for the given locale and code.
+func (c *Catalog) Template(locale, code string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	template, ok := c.templates[locale][code]
+	return template, ok
+}
+
+var defaultCatalog = newDefaultCatalog()
+
+func newDefaultCatalog() *Catalog {
+	c := NewCatalog()
+	c.RegisterBundle(LocaleJa, messagesJa)
+	c.RegisterBundle(LocaleEn, messagesEn)
+	return c
+}
+
+// DefaultCatalog returns the catalog used by contexts without their own catalog.
+// It contains the built-in "ja" and "en" bundles.
+func DefaultCatalog() *Catalog {
+	return defaultCatalog
+}
+
+// RegisterMessage adds or overrides a template in the default catalog.
+// It is typically called at startup, e.g. to translate a custom rule.
+func RegisterMessage(locale, code, template string) {
+	defaultCatalog.Register(locale, code, template)
+}
+
+// Message returns the default message for the given field, code and params,
+// resolved against the context's catalog and locale.
+// For each locale, the context's catalog is tried before the default catalog,
+// falling back to the base language (e.g. "en" for "en-US"), then to
+// DefaultLocale, and finally to the code itself.
+func (vc *ValidationContext) Message(field, code string, params map[string]interface{}) string {
+	if template, ok := vc.template(code); ok {
+		return formatMessage(template, field, params)
+	}
+	return code
+}
+
+// template resolves the template of code with the fallbacks of Message.
+func (vc *ValidationContext) template(code string) (string, bool) {
+	catalogs := []MessageCatalog{defaultCatalog}
+	if vc.cfg.catalog != nil {
+		catalogs = []MessageCatalog{vc.cfg.catalog, defaultCatalog}
+	}
+	locales := candidateLocales(vc.cfg.locale)
+	for _, locale := range locales {
+		for _, catalog := range catalogs {
+			if template, ok := catalog.Template(locale, code); ok {
+				return template, true
+			}
+		}
+	}
+	return "", false
+}
+
+func candidateLocales(locale string) []string {
+	locales := make([]string, 0, 3)
+	if locale != "" {
+		locales = append(locales, locale)
+		if i := strings.IndexAny(locale, "-_"); i > 0 {
+			locales = append(locales, locale[:i])
+		}
+	}
+	return append(locales, DefaultLocale)
+}
+
+// formatMessage replaces "{field}" and "{param}" placeholders in the template.
+func formatMessage(template, field string, params map[string]interface{}) string {
+	oldnew := make([]string, 0, 2+len(params)*2)
+	oldnew = append(oldnew, "{field}", field)
+	for key, value := range params {
+		oldnew = append(oldnew, "{"+key+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(oldnew...).Replace(template)
+}
@@ -0,0 +1,82 @@
+package validationcontext
+
+import (
+	"testing"
+)
+
+func TestDefaultMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		validate func(vc *ValidationContext)
+		want     string
+	}{
+		{"RequiredJa", "", func(vc *ValidationContext) { vc.Required("", "Name", "", false) }, "Nameは必須項目です。"},
+		{"RequiredEn", LocaleEn, func(vc *ValidationContext) { vc.Required("", "Name", "", false) }, "Name is required."},
+		{"MinLengthJa", LocaleJa, func(vc *ValidationContext) { vc.ValidateMinLength("ab", "Name", 3, "") }, "Nameは3文字以上で入力してください。"},
+		{"MinLengthEn", LocaleEn, func(vc *ValidationContext) { vc.ValidateMinLength("ab", "Name", 3, "") }, "Name must be at least 3 characters."},
+		{"RegionFallsBackToLanguage", "en-US", func(vc *ValidationContext) { vc.ValidateMaxValue(3, "Age", 2, "") }, "Age must be 2 or less."},
+		{"UnknownLocaleFallsBackToDefault", "fr", func(vc *ValidationContext) { vc.ValidateMaxValue(3, "Age", 2, "") }, "Ageは2以下で入力してください。"},
+		{"ErrMsgTakesPrecedence", LocaleEn, func(vc *ValidationContext) { vc.ValidateEmail("invalid", "Email", "Invalid email format") }, "Invalid email format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext(WithLocale(tt.locale))
+			tt.validate(vc)
+			if len(vc.Errors()) != 1 {
+				t.Fatalf("Expected error count: 1, got: %v", len(vc.Errors()))
+			}
+			if got := vc.Errors()[0].Message; got != tt.want {
+				t.Errorf("Expected message: %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRegisterMessage(t *testing.T) {
+	RegisterMessage(LocaleEn, "test_employee_code", "{field} must be {length} characters.")
+
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.AddErrorCode("EmployeeCode", "test_employee_code", "", map[string]interface{}{"length": 6})
+
+	want := "EmployeeCode must be 6 characters."
+	if got := vc.Errors()[0].Message; got != want {
+		t.Errorf("Expected message: %v, got: %v", want, got)
+	}
+}
+
+func TestWithCatalog(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.Register(LocaleEn, CodeRequired, "Please fill in {field}.")
+
+	vc := NewValidationContext(WithLocale(LocaleEn), WithCatalog(catalog))
+	vc.Required("", "Name", "", false)
+	vc.ValidateMinLength("ab", "Name", 3, "")
+
+	tests := []struct {
+		index int
+		want  string
+	}{
+		{0, "Please fill in Name."},
+		{1, "Name must be at least 3 characters."},
+	}
+	for _, tt := range tests {
+		if got := vc.Errors()[tt.index].Message; got != tt.want {
+			t.Errorf("Expected message: %v, got: %v", tt.want, got)
+		}
+	}
+}
+
+func TestWithCatalogFallback(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.Register(LocaleJa, CodeRequired, "{field}を入力してください。")
+
+	vc := NewValidationContext(WithLocale(LocaleEn), WithCatalog(catalog))
+	vc.Required("", "Name", "", false)
+
+	want := "Name is required."
+	if got := vc.Errors()[0].Message; got != want {
+		t.Errorf("Expected message: %v, got: %v", want, got)
+	}
+}
@@ -0,0 +1,85 @@
+package validationcontext
+
+// messagesEn is the built-in English bundle.
+var messagesEn = map[string]string{
+	CodeCustom:                  "{field} is invalid.",
+	CodeRequired:                "{field} is required.",
+	CodeRequiredIf:              "{field} is required when {other} is {value}.",
+	CodeRequiredUnless:          "{field} is required unless {other} is {value}.",
+	CodeRequiredWith:            "{field} is required when {other} is present.",
+	CodeRequiredWithout:         "{field} is required when {other} is not present.",
+	CodeMinLength:               "{field} must be at least {min} characters.",
+	CodeMaxLength:               "{field} must be at most {max} characters.",
+	CodeMinItems:                "{field} must contain at least {min} items.",
+	CodeMaxItems:                "{field} must contain at most {max} items.",
+	CodeEmail:                   "{field} must be a valid email address.",
+	CodeEmailLength:             "{field} is too long to be an email address.",
+	CodeEmailDisplayName:        "{field} must be an email address without a display name.",
+	CodeEmailBlockedDomain:      "The domain of {field} ({domain}) is not allowed.",
+	CodeEmailDomain:             "The domain of {field} ({domain}) cannot receive email.",
+	CodeContainsSpecial:         "{field} must contain a special character.",
+	CodeContainsNumber:          "{field} must contain a number.",
+	CodeContainsUpper:           "{field} must contain an uppercase letter.",
+	CodeContainsLower:           "{field} must contain a lowercase letter.",
+	CodeURL:                     "{field} must be a valid URL.",
+	CodeURLScheme:               "{field} must use one of the schemes: {schemes}.",
+	CodeURLPort:                 "The port of {field} ({port}) is not allowed.",
+	CodeURLLength:               "{field} must be at most {max} characters long.",
+	CodeURLUserInfo:             "{field} must not contain credentials.",
+	CodeURLPrivateIP:            "The host of {field} ({host}) is not allowed.",
+	CodeUUID:                    "{field} must be a valid UUID.",
+	CodeMinValue:                "{field} must be {min} or greater.",
+	CodeMaxValue:                "{field} must be {max} or less.",
+	CodeBetween:                 "{field} must be between {min} and {max}.",
+	CodeBetweenExclusive:        "{field} must be greater than {min} and less than {max}.",
+	CodePositive:                "{field} must be a positive number.",
+	CodeNegative:                "{field} must be a negative number.",
+	CodeNonZero:                 "{field} must not be zero.",
+	CodeMultipleOf:              "{field} must be a multiple of {step}.",
+	CodeFinite:                  "{field} must be a finite number.",
+	CodeDate:                    "{field} must be a valid date.",
+	CodeYearMonth:               "{field} must be a valid year and month.",
+	CodeYear:                    "{field} must be a valid year.",
+	CodeMonth:                   "{field} must be a valid month.",
+	CodeDateTime:                "{field} must be a valid date and time.",
+	CodeTime:                    "{field} must be a valid time.",
+	CodeBefore:                  "{field} must be before {time}.",
+	CodeAfter:                   "{field} must be after {time}.",
+	CodeEqualField:              "{field} must match {other}.",
+	CodeNotEqualField:           "{field} must be different from {other}.",
+	CodeGreaterThanField:        "{field} must be greater than {other}.",
+	CodeGreaterThanOrEqualField: "{field} must be greater than or equal to {other}.",
+	CodeLessThanField:           "{field} must be less than {other}.",
+	CodeLessThanOrEqualField:    "{field} must be less than or equal to {other}.",
+	CodeAfterField:              "{field} must be after {other}.",
+	CodeBeforeField:             "{field} must be before {other}.",
+	CodeFilePath:                "{field} must be a valid file path.",
+	CodeFileExtension:           "{field} must be a file with a valid extension ({extensions}).",
+	CodeFileSize:                "{field} must be {max_mb}MB or smaller.",
+	CodeFileStat:                "Failed to get file information for {field}: {error}",
+	CodePathNotExist:            "{field} does not exist.",
+	CodePathPermission:          "Permission denied for {field}.",
+	CodePathOutsideBase:         "{field} must be within the allowed directory.",
+	CodePathIsDirectory:         "{field} must be a file, not a directory.",
+	CodePathNotRegular:          "{field} must be a regular file.",
+	CodePathNotDirectory:        "{field} must be a directory.",
+	CodePathNotReadable:         "{field} is not readable.",
+	CodePathNotWritable:         "{field} is not writable.",
+	CodeFileRead:                "Failed to read the file of {field}: {error}",
+	CodeFileContentType:         "{field} must be a file of an allowed type ({allowed}).",
+	CodeFileExtensionMismatch:   "The extension of {field} ({extension}) does not match its content ({content_type}).",
+	CodeImageDecode:             "{field} must be a valid image.",
+	CodeImagePixels:             "{field} must have at most {max_pixels} pixels.",
+	CodeImageTooSmall:           "{field} ({width}x{height}) is too small.",
+	CodeImageTooLarge:           "{field} ({width}x{height}) is too large.",
+	CodeImageAspectRatio:        "{field} must have an aspect ratio of {expected}.",
+	CodeArchiveFormat:           "{field} must be a valid archive.",
+	CodeArchiveEntries:          "{field} must contain at most {max} files.",
+	CodeArchiveSize:             "{field} must be {max_mb}MB or smaller when extracted.",
+	CodeArchiveRatio:            "{field} is compressed too much.",
+	CodeArchiveDepth:            "{field} must not nest archives more than {max} levels deep.",
+	CodeArchivePath:             "{field} contains an unsafe path ({entry}).",
+	CodeArchiveExtension:        "{field} may only contain files with a valid extension ({extensions}).",
+	CodeAsync:                   "{field} could not be validated.",
+	CodeTimeout:                 "Validation of {field} timed out.",
+}
@@ -0,0 +1,85 @@
+package validationcontext
+
+// messagesJa is the built-in Japanese bundle.
+var messagesJa = map[string]string{
+	CodeCustom:                  "{field}の値が不正です。",
+	CodeRequired:                "{field}は必須項目です。",
+	CodeRequiredIf:              "{other}が{value}の場合、{field}は必須項目です。",
+	CodeRequiredUnless:          "{other}が{value}でない場合、{field}は必須項目です。",
+	CodeRequiredWith:            "{other}を指定する場合、{field}は必須項目です。",
+	CodeRequiredWithout:         "{other}を指定しない場合、{field}は必須項目です。",
+	CodeMinLength:               "{field}は{min}文字以上で入力してください。",
+	CodeMaxLength:               "{field}は{max}文字以内で入力してください。",
+	CodeMinItems:                "{field}は{min}件以上指定してください。",
+	CodeMaxItems:                "{field}は{max}件以内で指定してください。",
+	CodeEmail:                   "{field}には、有効なメールアドレスを指定してください。",
+	CodeEmailLength:             "{field}のメールアドレスが長すぎます。",
+	CodeEmailDisplayName:        "{field}には、表示名を含まないメールアドレスを指定してください。",
+	CodeEmailBlockedDomain:      "{field}のドメイン（{domain}）は使用できません。",
+	CodeEmailDomain:             "{field}のドメイン（{domain}）はメールを受信できません。",
+	CodeContainsSpecial:         "{field}には、特殊文字を含めてください。",
+	CodeContainsNumber:          "{field}には、数字を含めてください。",
+	CodeContainsUpper:           "{field}には、大文字の英字を含めてください。",
+	CodeContainsLower:           "{field}には、小文字の英字を含めてください。",
+	CodeURL:                     "{field}には、有効なURLを指定してください。",
+	CodeURLScheme:               "{field}のスキームは{schemes}のいずれかを指定してください。",
+	CodeURLPort:                 "{field}のポート（{port}）は使用できません。",
+	CodeURLLength:               "{field}のURLは{max}文字以内で入力してください。",
+	CodeURLUserInfo:             "{field}には、認証情報を含まないURLを指定してください。",
+	CodeURLPrivateIP:            "{field}のホスト（{host}）には接続できません。",
+	CodeUUID:                    "{field}には、有効なUUIDを指定してください。",
+	CodeMinValue:                "{field}は{min}以上で入力してください。",
+	CodeMaxValue:                "{field}は{max}以下で入力してください。",
+	CodeBetween:                 "{field}は{min}以上{max}以下で入力してください。",
+	CodeBetweenExclusive:        "{field}は{min}より大きく{max}未満で入力してください。",
+	CodePositive:                "{field}は正の数で入力してください。",
+	CodeNegative:                "{field}は負の数で入力してください。",
+	CodeNonZero:                 "{field}は0以外の値を入力してください。",
+	CodeMultipleOf:              "{field}は{step}の倍数で入力してください。",
+	CodeFinite:                  "{field}には、有限の数値を指定してください。",
+	CodeDate:                    "{field}には、有効な日付を指定してください。",
+	CodeYearMonth:               "{field}には、有効な年月を指定してください。",
+	CodeYear:                    "{field}には、有効な年を指定してください。",
+	CodeMonth:                   "{field}には、有効な月を指定してください。",
+	CodeDateTime:                "{field}には、有効な日時を指定してください。",
+	CodeTime:                    "{field}には、有効な時刻を指定してください。",
+	CodeBefore:                  "{field}には、{time}より前の日時を指定してください。",
+	CodeAfter:                   "{field}には、{time}より後の日時を指定してください。",
+	CodeEqualField:              "{field}は{other}と同じ値を入力してください。",
+	CodeNotEqualField:           "{field}は{other}と異なる値を入力してください。",
+	CodeGreaterThanField:        "{field}は{other}より大きい値を入力してください。",
+	CodeGreaterThanOrEqualField: "{field}は{other}以上の値を入力してください。",
+	CodeLessThanField:           "{field}は{other}より小さい値を入力してください。",
+	CodeLessThanOrEqualField:    "{field}は{other}以下の値を入力してください。",
+	CodeAfterField:              "{field}には、{other}より後の日時を指定してください。",
+	CodeBeforeField:             "{field}には、{other}より前の日時を指定してください。",
+	CodeFilePath:                "{field}には、有効なファイルパスを指定してください。",
+	CodeFileExtension:           "{field}には、有効な拡張子（{extensions}）を持つファイルを指定してください。",
+	CodeFileSize:                "{field}のファイルサイズは{max_mb}MB以下でなければなりません",
+	CodeFileStat:                "{field}のファイル情報の取得に失敗しました: {error}",
+	CodePathNotExist:            "{field}に指定されたパスは存在しません。",
+	CodePathPermission:          "{field}に指定されたパスにアクセスする権限がありません。",
+	CodePathOutsideBase:         "{field}には、許可されたディレクトリ内のパスを指定してください。",
+	CodePathIsDirectory:         "{field}にはディレクトリではなくファイルを指定してください。",
+	CodePathNotRegular:          "{field}には通常のファイルを指定してください。",
+	CodePathNotDirectory:        "{field}にはディレクトリを指定してください。",
+	CodePathNotReadable:         "{field}に指定されたパスは読み取りできません。",
+	CodePathNotWritable:         "{field}に指定されたパスは書き込みできません。",
+	CodeFileRead:                "{field}のファイルの読み込みに失敗しました: {error}",
+	CodeFileContentType:         "{field}には、許可された形式（{allowed}）のファイルを指定してください。",
+	CodeFileExtensionMismatch:   "{field}の拡張子（{extension}）がファイルの内容（{content_type}）と一致しません。",
+	CodeImageDecode:             "{field}には、有効な画像ファイルを指定してください。",
+	CodeImagePixels:             "{field}の画素数は{max_pixels}以下でなければなりません。",
+	CodeImageTooSmall:           "{field}の画像サイズ（{width}x{height}）が小さすぎます。",
+	CodeImageTooLarge:           "{field}の画像サイズ（{width}x{height}）が大きすぎます。",
+	CodeImageAspectRatio:        "{field}の縦横比は{expected}でなければなりません。",
+	CodeArchiveFormat:           "{field}には、有効なアーカイブファイルを指定してください。",
+	CodeArchiveEntries:          "{field}のファイル数は{max}以下でなければなりません。",
+	CodeArchiveSize:             "{field}の展開後のサイズは{max_mb}MB以下でなければなりません。",
+	CodeArchiveRatio:            "{field}の圧縮率が高すぎます。",
+	CodeArchiveDepth:            "{field}のアーカイブの入れ子は{max}階層までです。",
+	CodeArchivePath:             "{field}には、不正なパス（{entry}）が含まれています。",
+	CodeArchiveExtension:        "{field}には、許可された拡張子（{extensions}）のファイルのみ含めることができます。",
+	CodeAsync:                   "{field}の検証に失敗しました。",
+	CodeTimeout:                 "{field}の検証がタイムアウトしました。",
+}
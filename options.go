@@ -0,0 +1,45 @@
+package validationcontext
+
+import (
+	"time"
+)
+
+// Option configures a ValidationContext created by NewValidationContext.
+type Option func(*ValidationContext)
+
+// config holds the options of a context. It is shared by the views and
+// goroutine contexts derived from it.
+type config struct {
+	locale     string
+	catalog    MessageCatalog
+	stackTrace StackTraceMode
+	failFast   bool
+	bail       bool
+	maxErrors  int
+
+	asyncConcurrency int
+	asyncTimeout     time.Duration
+}
+
+// WithLocale sets the locale used to resolve default messages, e.g. LocaleEn.
+func WithLocale(locale string) Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.locale = locale
+	}
+}
+
+// WithCatalog sets the catalog used to resolve default messages.
+// Templates missing from the catalog fall back to the default catalog.
+func WithCatalog(catalog MessageCatalog) Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.catalog = catalog
+	}
+}
+
+// WithStackTrace sets how stack traces are captured for each error.
+// The default is StackTraceFull.
+func WithStackTrace(mode StackTraceMode) Option {
+	return func(vc *ValidationContext) {
+		vc.cfg.stackTrace = mode
+	}
+}
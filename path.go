@@ -0,0 +1,141 @@
+package validationcontext
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SegmentKind describes how a PathSegment addresses its parent.
+type SegmentKind int
+
+const (
+	// SegmentField is a named field, rendered as ".name".
+	SegmentField SegmentKind = iota
+	// SegmentIndex is a slice or array index, rendered as "[3]".
+	SegmentIndex
+	// SegmentKey is a map key, rendered as "[key]".
+	SegmentKey
+)
+
+// PathSegment is a single element of a field path.
+type PathSegment struct {
+	Kind  SegmentKind
+	Name  string
+	Index int
+}
+
+// Path is the structured location of a field, e.g. items[3].qty.
+type Path []PathSegment
+
+// Field returns a copy of the path with a named field appended.
+func (p Path) Field(name string) Path {
+	return p.append(PathSegment{Kind: SegmentField, Name: name})
+}
+
+// Index returns a copy of the path with a slice index appended.
+func (p Path) Index(i int) Path {
+	return p.append(PathSegment{Kind: SegmentIndex, Index: i})
+}
+
+// Key returns a copy of the path with a map key appended.
+func (p Path) Key(key string) Path {
+	return p.append(PathSegment{Kind: SegmentKey, Name: key})
+}
+
+func (p Path) append(seg PathSegment) Path {
+	path := make(Path, len(p), len(p)+1)
+	copy(path, p)
+	return append(path, seg)
+}
+
+// String renders the path with dots for fields and brackets for indexes
+// and keys, e.g. "order.items[3].qty" or "bundle[entry.pdf]".
+func (p Path) String() string {
+	var sb strings.Builder
+	for i, seg := range p {
+		switch seg.Kind {
+		case SegmentIndex:
+			sb.WriteString("[" + strconv.Itoa(seg.Index) + "]")
+		case SegmentKey:
+			sb.WriteString("[" + seg.Name + "]")
+		default:
+			if i > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(seg.Name)
+		}
+	}
+	return sb.String()
+}
+
+// Dotted renders every segment separated by dots, e.g. "order.items.3.qty".
+func (p Path) Dotted() string {
+	parts := make([]string, len(p))
+	for i, seg := range p {
+		parts[i] = seg.value()
+	}
+	return strings.Join(parts, ".")
+}
+
+// Bracketed renders the path in form-field notation, e.g. "order[items][3][qty]".
+func (p Path) Bracketed() string {
+	var sb strings.Builder
+	for i, seg := range p {
+		if i == 0 {
+			sb.WriteString(seg.value())
+			continue
+		}
+		sb.WriteString("[" + seg.value() + "]")
+	}
+	return sb.String()
+}
+
+// JSONPointer renders the path as an RFC 6901 JSON Pointer, e.g. "/order/items/3/qty".
+func (p Path) JSONPointer() string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	var sb strings.Builder
+	for _, seg := range p {
+		sb.WriteString("/" + escaper.Replace(seg.value()))
+	}
+	return sb.String()
+}
+
+func (s PathSegment) value() string {
+	if s.Kind == SegmentIndex {
+		return strconv.Itoa(s.Index)
+	}
+	return s.Name
+}
+
+// Scope returns a view of the context whose fields are prefixed with name,
+// e.g. vc.Scope("address").Required(street, "street", "", false) reports "address.street".
+// The view writes into the same context as vc.
+func (vc *ValidationContext) Scope(name string) *ValidationContext {
+	return vc.view(vc.path.Field(name))
+}
+
+// Index returns a view of the context whose fields are prefixed with a slice index,
+// e.g. vc.Scope("items").Index(3) reports "items[3].qty".
+func (vc *ValidationContext) Index(i int) *ValidationContext {
+	return vc.view(vc.path.Index(i))
+}
+
+// Key returns a view of the context whose fields are prefixed with a map key,
+// e.g. vc.Scope("labels").Key("en") reports "labels[en].text".
+func (vc *ValidationContext) Key(key string) *ValidationContext {
+	return vc.view(vc.path.Key(key))
+}
+
+// Path returns the field path prefix of the context.
+func (vc *ValidationContext) Path() Path {
+	return vc.path
+}
+
+func (vc *ValidationContext) view(path Path) *ValidationContext {
+	return &ValidationContext{
+		cfg:      vc.cfg,
+		root:     vc.store(),
+		path:     path,
+		severity: vc.severity,
+	}
+}
@@ -0,0 +1,93 @@
+package validationcontext
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPathFormats(t *testing.T) {
+	path := Path{}.Field("order").Field("items").Index(3).Field("qty")
+	keyPath := Path{}.Field("bundle").Key("docs/entry.pdf")
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"String", path.String(), "order.items[3].qty"},
+		{"Dotted", path.Dotted(), "order.items.3.qty"},
+		{"Bracketed", path.Bracketed(), "order[items][3][qty]"},
+		{"JSONPointer", path.JSONPointer(), "/order/items/3/qty"},
+		{"KeyString", keyPath.String(), "bundle[docs/entry.pdf]"},
+		{"KeyJSONPointer", keyPath.JSONPointer(), "/bundle/docs~1entry.pdf"},
+		{"Empty", Path{}.String(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Expected: %v, got: %v", tt.want, tt.got)
+			}
+		})
+	}
+}
+
+func TestScope(t *testing.T) {
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	address := vc.Scope("address")
+	address.Required("", "street", "", false)
+	items := vc.Scope("items")
+	for i, qty := range []int{1, 0, 5} {
+		items.Index(i).ValidateMinValue(qty, "qty", 1, "")
+	}
+	vc.Scope("labels").Key("en").ValidateMaxLength("abc", "text", 2, "")
+	vc.Required("", "name", "", false)
+
+	tests := []struct {
+		field   string
+		path    Path
+		message string
+	}{
+		{"address.street", Path{}.Field("address").Field("street"), "street is required."},
+		{"items[1].qty", Path{}.Field("items").Index(1).Field("qty"), "qty must be 1 or greater."},
+		{"labels[en].text", Path{}.Field("labels").Key("en").Field("text"), "text must be at most 2 characters."},
+		{"name", Path{}.Field("name"), "name is required."},
+	}
+
+	errs := address.Errors()
+	if len(errs) != len(tests) {
+		t.Fatalf("Expected error count: %v, got: %v", len(tests), len(errs))
+	}
+	for i, tt := range tests {
+		if errs[i].Field != tt.field {
+			t.Errorf("Expected field: %v, got: %v", tt.field, errs[i].Field)
+		}
+		if errs[i].Path.String() != tt.path.String() || len(errs[i].Path) != len(tt.path) {
+			t.Errorf("Expected path: %v, got: %v", tt.path, errs[i].Path)
+		}
+		if errs[i].Message != tt.message {
+			t.Errorf("Expected message: %v, got: %v", tt.message, errs[i].Message)
+		}
+	}
+}
+
+func TestScopeGo(t *testing.T) {
+	vc := NewValidationContext()
+	items := vc.Scope("items")
+	for i := 0; i < 3; i++ {
+		items.Index(i).Go(func(vc *ValidationContext) {
+			vc.Required("", "name", "", false)
+		})
+	}
+	items.Wait(context.Background())
+
+	errs := vc.Errors()
+	if len(errs) != 3 {
+		t.Fatalf("Expected error count: 3, got: %v", len(errs))
+	}
+	for i, want := range []string{"items[0].name", "items[1].name", "items[2].name"} {
+		if errs[i].Field != want {
+			t.Errorf("Expected field: %v, got: %v", want, errs[i].Field)
+		}
+	}
+}
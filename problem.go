@@ -0,0 +1,64 @@
+package validationcontext
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// ProblemDetails is an RFC 7807 problem details object describing validation errors.
+// The errors are listed in the "invalid-params" extension member.
+type ProblemDetails struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params"`
+}
+
+// InvalidParam is an entry of the "invalid-params" extension member.
+type InvalidParam struct {
+	Name   string                 `json:"name"`
+	Reason string                 `json:"reason"`
+	Code   string                 `json:"code,omitempty"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// ProblemDetails returns the validation errors of the context as RFC 7807
+// problem details with status 422 Unprocessable Entity.
+// Stack traces are not included.
+func (vc *ValidationContext) ProblemDetails() *ProblemDetails {
+	errs := vc.Errors()
+	params := make([]InvalidParam, len(errs))
+	for i, err := range errs {
+		params[i] = InvalidParam{
+			Name:   err.Field,
+			Reason: err.Message,
+			Code:   err.Code,
+			Params: err.Params,
+		}
+	}
+	return &ProblemDetails{
+		Type:          "about:blank",
+		Title:         "Your request parameters didn't validate.",
+		Status:        http.StatusUnprocessableEntity,
+		InvalidParams: params,
+	}
+}
+
+// WriteProblem writes the validation errors of the context to w as an
+// application/problem+json response with status 422. If r is not nil, its
+// path is used as the problem instance. It is meant to be called from an
+// http.Handler once HasErrors reports true.
+func (vc *ValidationContext) WriteProblem(w http.ResponseWriter, r *http.Request) error {
+	problem := vc.ProblemDetails()
+	if r != nil && r.URL != nil {
+		problem.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	return json.NewEncoder(w).Encode(problem)
+}
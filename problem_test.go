@@ -0,0 +1,111 @@
+package validationcontext
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestValidationErrorJSON(t *testing.T) {
+	err := ValidationError{
+		Field:   "name",
+		Code:    CodeMinLength,
+		Params:  map[string]interface{}{"min": 3},
+		Message: "name is too short",
+	}
+
+	data, jsonErr := json.Marshal(err)
+	if jsonErr != nil {
+		t.Fatalf("Failed to marshal: %v", jsonErr)
+	}
+	expected := `{"field":"name","code":"min_length","params":{"min":3},"message":"name is too short"}`
+	if string(data) != expected {
+		t.Errorf("Expected: %s, got: %s", expected, data)
+	}
+}
+
+func TestValidationAggregateErrorJSON(t *testing.T) {
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.Required("", "name", "", false)
+
+	data, err := json.Marshal(vc.AggregateError())
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+
+	var got struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Field   string `json:"field"`
+			Code    string `json:"code"`
+			Message string `json:"message"`
+			Trace   string `json:"trace"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if got.Message != "Validation errors: Field: name, Error: name is required." {
+		t.Errorf("Unexpected message: %s", got.Message)
+	}
+	if len(got.Errors) != 1 || got.Errors[0].Field != "name" || got.Errors[0].Code != CodeRequired {
+		t.Errorf("Unexpected errors: %+v", got.Errors)
+	}
+	if got.Errors[0].Trace != "" {
+		t.Errorf("Expected no trace by default, got: %s", got.Errors[0].Trace)
+	}
+
+	data, err = vc.AggregateError().(*ValidationAggregateError).MarshalJSONWithTraces()
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if len(got.Errors) != 1 || got.Errors[0].Code != CodeRequired || got.Errors[0].Trace == "" {
+		t.Errorf("Expected the error with its trace, got: %+v", got.Errors)
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.Scope("address").Required("", "street", "", false)
+	vc.ValidateMinLength("ab", "name", 3, "")
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if vc.HasErrors() {
+			if err := vc.WriteProblem(w, r); err != nil {
+				t.Errorf("WriteProblem() error = %v", err)
+			}
+		}
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/users", nil))
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status: %d, got: %d", http.StatusUnprocessableEntity, rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != ProblemContentType {
+		t.Errorf("Expected content type: %s, got: %s", ProblemContentType, ct)
+	}
+
+	var problem map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	expected := map[string]interface{}{
+		"type":     "about:blank",
+		"title":    "Your request parameters didn't validate.",
+		"status":   float64(422),
+		"instance": "/users",
+		"invalid-params": []interface{}{
+			map[string]interface{}{"name": "address.street", "reason": "street is required.", "code": "required"},
+			map[string]interface{}{"name": "name", "reason": "name must be at least 3 characters.", "code": "min_length", "params": map[string]interface{}{"min": float64(3)}},
+		},
+	}
+	if !reflect.DeepEqual(problem, expected) {
+		t.Errorf("Expected: %v, got: %v", expected, problem)
+	}
+}
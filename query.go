@@ -0,0 +1,97 @@
+package validationcontext
+
+import "strings"
+
+// ErrorsByField returns the blocking errors of the context grouped by their
+// rendered path, e.g. "address.street" or "items[3].qty".
+func (vc *ValidationContext) ErrorsByField() map[string][]ValidationError {
+	return errorsByField(vc.Errors())
+}
+
+// FieldErrors returns the blocking errors of a field, in the order they were added.
+//
+// field is the full rendered path, also for views created by Scope, Index or Key.
+// A pattern ending in ".*" matches the nested fields of a path segment by
+// segment: "address.*" matches "address.street" and "address.geo.lat", but not
+// "address" itself nor "addressBook.city".
+func (vc *ValidationContext) FieldErrors(field string) []ValidationError {
+	return fieldErrors(vc.Errors(), field)
+}
+
+// FirstError returns the first blocking error of a field, or nil if there is none.
+// field is matched as in FieldErrors.
+func (vc *ValidationContext) FirstError(field string) *ValidationError {
+	return firstError(vc.Errors(), field)
+}
+
+// HasFieldError reports whether a field has a blocking error.
+// field is matched as in FieldErrors.
+func (vc *ValidationContext) HasFieldError(field string) bool {
+	return vc.FirstError(field) != nil
+}
+
+// ErrorsByField returns the aggregated errors grouped by their rendered path.
+func (e *ValidationAggregateError) ErrorsByField() map[string][]ValidationError {
+	return errorsByField(e.Errors)
+}
+
+// FieldErrors returns the aggregated errors of a field, see ValidationContext.FieldErrors.
+func (e *ValidationAggregateError) FieldErrors(field string) []ValidationError {
+	return fieldErrors(e.Errors, field)
+}
+
+// FirstError returns the first aggregated error of a field, or nil if there is none.
+func (e *ValidationAggregateError) FirstError(field string) *ValidationError {
+	return firstError(e.Errors, field)
+}
+
+// HasFieldError reports whether a field has an aggregated error.
+func (e *ValidationAggregateError) HasFieldError(field string) bool {
+	return e.FirstError(field) != nil
+}
+
+func errorsByField(errs []ValidationError) map[string][]ValidationError {
+	byField := make(map[string][]ValidationError)
+	for _, err := range errs {
+		byField[err.Field] = append(byField[err.Field], err)
+	}
+	return byField
+}
+
+func fieldErrors(errs []ValidationError, field string) []ValidationError {
+	var matched []ValidationError
+	for _, err := range errs {
+		if matchesField(err, field) {
+			matched = append(matched, err)
+		}
+	}
+	return matched
+}
+
+func firstError(errs []ValidationError, field string) *ValidationError {
+	for i := range errs {
+		if matchesField(errs[i], field) {
+			return &errs[i]
+		}
+	}
+	return nil
+}
+
+// matchesField reports whether the error is reported on field, or on a nested
+// field of prefix for a pattern of the form "prefix.*".
+func matchesField(err ValidationError, field string) bool {
+	prefix, ok := strings.CutSuffix(field, ".*")
+	if !ok {
+		return err.Field == field
+	}
+	if len(err.Path) == 0 {
+		// Errors built by hand may only have a Field.
+		return strings.HasPrefix(err.Field, prefix+".") || strings.HasPrefix(err.Field, prefix+"[")
+	}
+	for i := 1; i < len(err.Path); i++ {
+		if err.Path[:i].String() == prefix {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package validationcontext
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func newQueryTestContext() *ValidationContext {
+	vc := NewValidationContext()
+	vc.Required("", "name", "", false)
+	vc.ValidateMinLength("a", "name", 2, "")
+	address := vc.Scope("address")
+	address.Required("", "street", "", false)
+	address.Scope("geo").ValidateMinValue(-100, "lat", -90, "")
+	vc.Scope("addressBook").Required("", "city", "", false)
+	vc.Scope("items").Index(0).Required("", "qty", "", false)
+	vc.AddError("address", "address is invalid")
+	vc.AddWarning("name", "name looks like a placeholder")
+	return vc
+}
+
+func TestFieldQueries(t *testing.T) {
+	vc := newQueryTestContext()
+	var aggErr *ValidationAggregateError
+	if !errors.As(vc.AggregateError(), &aggErr) {
+		t.Fatalf("Expected a ValidationAggregateError")
+	}
+
+	tests := []struct {
+		name       string
+		field      string
+		wantFields []string
+		wantCodes  []string
+	}{
+		{"Exact", "name", []string{"name", "name"}, []string{CodeRequired, CodeMinLength}},
+		{"Nested", "address.street", []string{"address.street"}, []string{CodeRequired}},
+		{"Prefix", "address.*", []string{"address.street", "address.geo.lat"}, []string{CodeRequired, CodeMinValue}},
+		{"NestedPrefix", "address.geo.*", []string{"address.geo.lat"}, []string{CodeMinValue}},
+		{"IndexPrefix", "items.*", []string{"items[0].qty"}, []string{CodeRequired}},
+		{"ParentOnly", "address", []string{"address"}, []string{CodeCustom}},
+		{"None", "email", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, errs := range [][]ValidationError{vc.FieldErrors(tt.field), aggErr.FieldErrors(tt.field)} {
+				if len(errs) != len(tt.wantFields) {
+					t.Fatalf("Expected error count: %v, got: %v", len(tt.wantFields), errs)
+				}
+				for i := range errs {
+					if errs[i].Field != tt.wantFields[i] || errs[i].Code != tt.wantCodes[i] {
+						t.Errorf("Expected %v (%v), got: %v (%v)", tt.wantFields[i], tt.wantCodes[i], errs[i].Field, errs[i].Code)
+					}
+				}
+			}
+
+			wantHas := len(tt.wantFields) > 0
+			if vc.HasFieldError(tt.field) != wantHas || aggErr.HasFieldError(tt.field) != wantHas {
+				t.Errorf("Expected HasFieldError: %v", wantHas)
+			}
+			first, aggFirst := vc.FirstError(tt.field), aggErr.FirstError(tt.field)
+			if !wantHas {
+				if first != nil || aggFirst != nil {
+					t.Errorf("Expected no first error, got: %v", first)
+				}
+				return
+			}
+			if first == nil || first.Code != tt.wantCodes[0] || aggFirst == nil || aggFirst.Code != tt.wantCodes[0] {
+				t.Errorf("Expected first error with code: %v, got: %v", tt.wantCodes[0], first)
+			}
+		})
+	}
+}
+
+func TestErrorsByField(t *testing.T) {
+	vc := newQueryTestContext()
+	byField := vc.ErrorsByField()
+
+	wantCounts := map[string]int{
+		"name":             2,
+		"address":          1,
+		"address.street":   1,
+		"address.geo.lat":  1,
+		"addressBook.city": 1,
+		"items[0].qty":     1,
+	}
+	counts := make(map[string]int)
+	for field, errs := range byField {
+		counts[field] = len(errs)
+	}
+	if !reflect.DeepEqual(counts, wantCounts) {
+		t.Errorf("Expected: %v, got: %v", wantCounts, counts)
+	}
+
+	aggErr := vc.AggregateError().(*ValidationAggregateError)
+	if !reflect.DeepEqual(aggErr.ErrorsByField(), byField) {
+		t.Errorf("Expected the aggregate error to group the same errors")
+	}
+}
+
+func TestFieldQueriesWithoutPath(t *testing.T) {
+	aggErr := &ValidationAggregateError{Errors: []ValidationError{
+		{Field: "address.street", Code: CodeRequired},
+		{Field: "addressBook.city", Code: CodeRequired},
+	}}
+	if errs := aggErr.FieldErrors("address.*"); len(errs) != 1 || errs[0].Field != "address.street" {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+}
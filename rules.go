@@ -0,0 +1,232 @@
+package validationcontext
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RuleFunc validates a value against a registered rule.
+// value is passed as is, e.g. a *os.File or a *string; rules are not applied
+// to nil values. It returns nil if the value is valid. A returned ValidationError (or a pointer
+// to one) sets the Code, Message and Params of the recorded error; any other
+// error is recorded with the rule name as its code.
+type RuleFunc func(value interface{}, params RuleParams) error
+
+// RuleParams holds the arguments of a rule, e.g. "EMP" and "6" for the tag
+// `validate:"employee_code=EMP 6"`. The typed accessors panic if the argument
+// is missing or malformed, as the built-in tag rules do.
+type RuleParams struct {
+	rule string
+	args []string
+}
+
+// Len returns the number of arguments.
+func (p RuleParams) Len() int {
+	return len(p.args)
+}
+
+// Strings returns a copy of all arguments.
+func (p RuleParams) Strings() []string {
+	return append([]string(nil), p.args...)
+}
+
+// String returns the i-th argument.
+func (p RuleParams) String(i int) string {
+	if i < 0 || i >= len(p.args) {
+		panic(fmt.Sprintf("validationcontext: rule %q requires at least %d parameters, got %d", p.rule, i+1, len(p.args)))
+	}
+	return p.args[i]
+}
+
+// Int returns the i-th argument parsed as an int.
+func (p RuleParams) Int(i int) int {
+	return atoiParam(p.rule, p.String(i))
+}
+
+// Float returns the i-th argument parsed as a float64.
+func (p RuleParams) Float(i int) float64 {
+	return parseFloatParam(p.rule, p.String(i))
+}
+
+// Bool returns the i-th argument parsed with strconv.ParseBool.
+func (p RuleParams) Bool(i int) bool {
+	b, err := strconv.ParseBool(p.String(i))
+	if err != nil {
+		panic(fmt.Sprintf("validationcontext: rule %q requires a boolean parameter, got %q", p.rule, p.args[i]))
+	}
+	return b
+}
+
+// messageParams returns the error params of the rule: "param" holds all
+// arguments separated by spaces and "0", "1", ... hold each argument.
+func (p RuleParams) messageParams() map[string]interface{} {
+	params := make(map[string]interface{}, len(p.args)+1)
+	params["param"] = strings.Join(p.args, " ")
+	for i, arg := range p.args {
+		params[strconv.Itoa(i)] = arg
+	}
+	return params
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   = make(map[string]RuleFunc)
+)
+
+// RegisterRule registers a custom rule under name, which is also the code of
+// its errors. messages maps locales to message templates and is added to the
+// default catalog; templates may reference "{field}", "{param}" (all arguments)
+// and "{0}", "{1}", ... (each argument). When no template resolves for the
+// locale of the context, see Message, the message is the text of the error
+// returned by fn.
+//
+// A registered rule can be used from struct tags, from rule chains with Rule,
+// and with ValidateRule and ValidateTag:
+//
+//	validationcontext.RegisterRule("employee_code", func(value interface{}, params validationcontext.RuleParams) error {
+//		s, _ := value.(string)
+//		if !strings.HasPrefix(s, params.String(0)) || len(s) != params.Int(1) {
+//			return errors.New("invalid employee code")
+//		}
+//		return nil
+//	}, map[string]string{
+//		validationcontext.LocaleEn: "{field} must be a {1}-character code starting with {0}.",
+//	})
+//
+//	Code string `validate:"required,employee_code=EMP 6"`
+//
+// It is typically called at startup. It panics if name is empty, contains a
+// comma, "=" or a space, or is the name of a built-in rule.
+func RegisterRule(name string, fn RuleFunc, messages map[string]string) {
+	if name == "" || strings.ContainsAny(name, ",= ") {
+		panic(fmt.Sprintf("validationcontext: invalid rule name %q", name))
+	}
+	if _, ok := tagRules[name]; ok || isReservedRule(name) {
+		panic(fmt.Sprintf("validationcontext: rule %q is built in", name))
+	}
+	if fn == nil {
+		panic(fmt.Sprintf("validationcontext: rule %q has a nil function", name))
+	}
+	for locale, template := range messages {
+		RegisterMessage(locale, name, template)
+	}
+
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+	rules[name] = fn
+}
+
+func isReservedRule(name string) bool {
+	switch name {
+	case "required", "omitempty", "required_if", "required_unless", "required_with", "required_without":
+		return true
+	}
+	return false
+}
+
+func lookupRule(name string) (RuleFunc, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	rule, ok := rules[name]
+	return rule, ok
+}
+
+// ValidateRule applies the rule registered under name, or the built-in tag rule
+// of that name, to value. args are formatted with fmt.Sprint, e.g.
+//
+//	vc.ValidateRule(code, "Code", "employee_code", "EMP", 6)
+//
+// It panics if no rule is registered under name.
+func (vc *ValidationContext) ValidateRule(value interface{}, field, name string, args ...interface{}) {
+	params := make([]string, len(args))
+	for i, arg := range args {
+		params[i] = fmt.Sprint(arg)
+	}
+	original := reflect.ValueOf(value)
+	rv, isNil := indirectValue(original)
+	if isNil {
+		return
+	}
+	vc.applyRule(field, tagRule{name: name, param: strings.Join(params, " ")}, original, rv)
+}
+
+// ValidateTag validates value with a rule list in the syntax of struct tags,
+// e.g. "required,min=3,employee_code=EMP 6", so that rules can be declared in
+// configuration. Conditional requirements are not supported because they refer
+// to sibling fields.
+func (vc *ValidationContext) ValidateTag(value interface{}, field, tag string) {
+	f := structField{name: field}
+	parseTagRules(&f, tag)
+	if len(f.conditions) > 0 {
+		panic(fmt.Sprintf("validationcontext: rule %q requires a struct field", f.conditions[0].name))
+	}
+	checkTagRules(f, reflect.TypeOf(value))
+	vc.validateField(f, reflect.ValueOf(&value).Elem(), reflect.Value{}, make(map[pointerVisit]bool))
+}
+
+// applyRule applies a built-in rule to the dereferenced value, or a registered
+// rule to the original one.
+func (vc *ValidationContext) applyRule(field string, rule tagRule, original, value reflect.Value) {
+	if builtin, ok := tagRules[rule.name]; ok {
+		// The dynamic type of an interface field was not checked when the
+		// struct type was parsed, and depends on the data.
+		if isInterfaceType(original.Type()) {
+			if err := builtin.check(value.Type(), rule.param); err != nil {
+				if !vc.ShouldSkip(field) {
+					vc.AddErrorCode(field, CodeCustom, "", map[string]interface{}{"rule": rule.name, "error": err.Error()})
+				}
+				return
+			}
+		}
+		builtin.apply(vc, field, value, rule.param)
+		return
+	}
+	fn, ok := lookupRule(rule.name)
+	if !ok {
+		panic(fmt.Sprintf("validationcontext: unknown rule %q on field %s", rule.name, field))
+	}
+	if vc.ShouldSkip(field) {
+		return
+	}
+
+	params := RuleParams{rule: rule.name, args: strings.Fields(rule.param)}
+	err := fn(original.Interface(), params)
+	if err == nil {
+		return
+	}
+	var validationErrPtr *ValidationError
+	var validationErr ValidationError
+	switch {
+	case errors.As(err, &validationErrPtr):
+		validationErr = *validationErrPtr
+	case errors.As(err, &validationErr):
+	default:
+		message := ""
+		if _, ok := vc.template(rule.name); !ok {
+			message = err.Error()
+		}
+		vc.AddErrorCode(field, rule.name, message, params.messageParams())
+		return
+	}
+	code := validationErr.Code
+	if code == "" {
+		code = rule.name
+	}
+	errParams := validationErr.Params
+	if errParams == nil {
+		errParams = params.messageParams()
+	}
+	vc.AddErrorCode(field, code, validationErr.Message, errParams)
+}
+
+// isInterfaceType reports whether t is an interface or a pointer to one.
+func isInterfaceType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Interface
+}
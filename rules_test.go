@@ -0,0 +1,174 @@
+package validationcontext
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func init() {
+	RegisterRule("test_staff_code", func(value interface{}, params RuleParams) error {
+		s, _ := value.(string)
+		if !strings.HasPrefix(s, params.String(0)) || len(s) != params.Int(1) {
+			return errors.New("invalid employee code")
+		}
+		return nil
+	}, map[string]string{
+		LocaleJa: "{field}は{0}で始まる{1}文字のコードを入力してください。",
+		LocaleEn: "{field} must be a {1}-character code starting with {0}.",
+	})
+	RegisterRule("test_even", func(value interface{}, params RuleParams) error {
+		if n, ok := value.(int); ok && n%2 != 0 {
+			return errors.New("must be even")
+		}
+		return nil
+	}, nil)
+	RegisterRule("test_en_only", func(value interface{}, params RuleParams) error {
+		return errors.New("en only")
+	}, map[string]string{
+		LocaleEn: "{field} is invalid.",
+	})
+	RegisterRule("test_not_reserved", func(value interface{}, params RuleParams) error {
+		if s, ok := value.(*string); ok && *s == "admin" {
+			return ValidationError{Code: "reserved", Message: "admin is reserved"}
+		}
+		return nil
+	}, nil)
+}
+
+type ruleTestEmployee struct {
+	Code  string  `json:"code" validate:"required,test_staff_code=EMP 6"`
+	Grade int     `json:"grade" validate:"test_even"`
+	Name  *string `json:"name" validate:"test_not_reserved"`
+}
+
+func TestRegisteredRuleInStruct(t *testing.T) {
+	admin := "admin"
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.ValidateStruct(ruleTestEmployee{Code: "EMP1", Grade: 3, Name: &admin})
+
+	errs := vc.Errors()
+	wantCodes := []string{"test_staff_code", "test_even", "reserved"}
+	if len(errs) != len(wantCodes) {
+		t.Fatalf("Expected error count: %v, got: %v", len(wantCodes), errs)
+	}
+	for i, code := range wantCodes {
+		if errs[i].Code != code {
+			t.Errorf("Expected code: %v, got: %v", code, errs[i].Code)
+		}
+	}
+	if errs[0].Message != "code must be a 6-character code starting with EMP." {
+		t.Errorf("Unexpected message: %v", errs[0].Message)
+	}
+	if errs[0].Params["param"] != "EMP 6" || errs[0].Params["0"] != "EMP" {
+		t.Errorf("Unexpected params: %v", errs[0].Params)
+	}
+	if errs[1].Message != "must be even" {
+		t.Errorf("Expected the error text without a template, got: %v", errs[1].Message)
+	}
+	if errs[2].Message != "admin is reserved" {
+		t.Errorf("Unexpected message: %v", errs[2].Message)
+	}
+
+	vc = NewValidationContext()
+	vc.ValidateStruct(ruleTestEmployee{Code: "EMP123", Grade: 2})
+	if vc.HasErrors() {
+		t.Errorf("Expected no errors, got: %v", vc.Errors())
+	}
+}
+
+func TestRegisteredRuleMessageFallback(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{LocaleEn, "code is invalid."},
+		{"en-US", "code is invalid."},
+		{LocaleJa, "en only"},
+		{"fr", "en only"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			vc := NewValidationContext(WithLocale(tt.locale))
+			vc.ValidateRule("x", "code", "test_en_only")
+			if errs := vc.Errors(); len(errs) != 1 || errs[0].Message != tt.want {
+				t.Errorf("Expected message: %v, got: %v", tt.want, errs)
+			}
+		})
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(vc *ValidationContext)
+		wantCode string
+	}{
+		{"Registered", func(vc *ValidationContext) { vc.ValidateRule("EMP1", "Code", "test_staff_code", "EMP", 6) }, "test_staff_code"},
+		{"RegisteredValid", func(vc *ValidationContext) { vc.ValidateRule("EMP123", "Code", "test_staff_code", "EMP", 6) }, ""},
+		{"BuiltIn", func(vc *ValidationContext) { vc.ValidateRule("ab", "Name", "min", 3) }, CodeMinLength},
+		{"Nil", func(vc *ValidationContext) { vc.ValidateRule(nil, "Code", "test_staff_code", "EMP", 6) }, ""},
+		{"Chain", func(vc *ValidationContext) { vc.Field("Code", "X").Required().Rule("test_staff_code", "EMP", 6) }, "test_staff_code"},
+		{"NumberChain", func(vc *ValidationContext) { NumberField(vc, "Grade", 3).Min(1).Rule("test_even") }, "test_even"},
+		{"Tag", func(vc *ValidationContext) { vc.ValidateTag("EMP1", "Code", "required,test_staff_code=EMP 6") }, "test_staff_code"},
+		{"TagRequired", func(vc *ValidationContext) { vc.ValidateTag("", "Code", "required") }, CodeRequired},
+		{"TagOmitEmpty", func(vc *ValidationContext) { vc.ValidateTag(nil, "Code", "omitempty,test_staff_code=EMP 6") }, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			tt.validate(vc)
+
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("Expected 1 error, got: %v", errs)
+			}
+			if errs[0].Code != tt.wantCode {
+				t.Errorf("Expected code: %v, got: %v", tt.wantCode, errs[0].Code)
+			}
+		})
+	}
+}
+
+func TestRegisterRulePanics(t *testing.T) {
+	fn := func(value interface{}, params RuleParams) error { return nil }
+	tests := []struct {
+		name string
+		rule string
+		fn   RuleFunc
+	}{
+		{"Empty", "", fn},
+		{"BuiltIn", "email", fn},
+		{"Reserved", "required", fn},
+		{"Separator", "a,b", fn},
+		{"NilFunc", "test_nil", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected RegisterRule to panic")
+				}
+			}()
+			RegisterRule(tt.rule, tt.fn, nil)
+		})
+	}
+}
+
+func TestRuleParamsPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Expected a malformed parameter to panic")
+		}
+	}()
+	NewValidationContext().ValidateRule("EMP1", "Code", "test_staff_code", "EMP", "six")
+}
@@ -0,0 +1,131 @@
+package validationcontext
+
+import "fmt"
+
+// Severity tells whether a ValidationError blocks the validation.
+// The zero value is SeverityError.
+type Severity int
+
+const (
+	// SeverityError is a blocking error, reported by HasErrors and AggregateError.
+	SeverityError Severity = iota
+	// SeverityWarning is a non-blocking problem to show to the user,
+	// e.g. a weak but allowed password.
+	SeverityWarning
+	// SeverityInfo is a non-blocking notice.
+	SeverityInfo
+)
+
+// String returns "error", "warning" or "info".
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// MarshalText encodes the severity as its name, e.g. in JSON.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity name.
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "error":
+		*s = SeverityError
+	case "warning":
+		*s = SeverityWarning
+	case "info":
+		*s = SeverityInfo
+	default:
+		return fmt.Errorf("validationcontext: unknown severity %q", text)
+	}
+	return nil
+}
+
+// AsWarning returns a view of the context that records its errors as warnings,
+// so that any validator can be used for a non-blocking check, e.g.
+//
+//	vc.AsWarning().ValidateFileSize(file, "Upload", 10*1024*1024, "")
+//
+// Warnings are not counted by HasErrors, WithFailFast or WithMaxErrors and do
+// not stop a field or rule chain in bail mode.
+func (vc *ValidationContext) AsWarning() *ValidationContext {
+	return vc.withSeverity(SeverityWarning)
+}
+
+// AsInfo returns a view of the context that records its errors as SeverityInfo.
+func (vc *ValidationContext) AsInfo() *ValidationContext {
+	return vc.withSeverity(SeverityInfo)
+}
+
+// AddWarning adds a warning with CodeCustom to the context.
+func (vc *ValidationContext) AddWarning(field, message string) {
+	vc.AsWarning().AddErrorCode(field, CodeCustom, message, nil)
+}
+
+// AddInfo adds a notice with CodeCustom to the context.
+func (vc *ValidationContext) AddInfo(field, message string) {
+	vc.AsInfo().AddErrorCode(field, CodeCustom, message, nil)
+}
+
+// Warnings returns a copy of the warnings that have been added to the context.
+func (vc *ValidationContext) Warnings() []ValidationError {
+	return vc.filterIssues(SeverityWarning)
+}
+
+// HasWarnings returns true if there are any warnings in the context.
+func (vc *ValidationContext) HasWarnings() bool {
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.countLocked(SeverityWarning) > 0
+}
+
+// Issues returns a copy of the errors of all severities, in the order they were added.
+func (vc *ValidationContext) Issues() []ValidationError {
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	errs := make([]ValidationError, len(s.errors))
+	copy(errs, s.errors)
+	return errs
+}
+
+func (vc *ValidationContext) withSeverity(severity Severity) *ValidationContext {
+	v := vc.view(vc.path)
+	v.chain = vc.chain
+	v.severity = severity
+	return v
+}
+
+func (vc *ValidationContext) filterIssues(severity Severity) []ValidationError {
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	errs := make([]ValidationError, 0, len(s.errors))
+	for _, err := range s.errors {
+		if err.Severity == severity {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// countLocked returns the number of stored errors with the given severity.
+// It must be called on the store with its mutex held.
+func (vc *ValidationContext) countLocked(severity Severity) int {
+	n := 0
+	for _, err := range vc.errors {
+		if err.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
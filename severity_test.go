@@ -0,0 +1,101 @@
+package validationcontext
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSeverity(t *testing.T) {
+	vc := NewValidationContext()
+	vc.AddWarning("Password", "Password is weak")
+	vc.AddInfo("Upload", "Upload will be resized")
+	vc.AsWarning().Scope("profile").ValidateMaxLength("a long biography", "bio", 5, "")
+
+	if vc.HasErrors() {
+		t.Errorf("Expected warnings not to count as errors")
+	}
+	if !vc.HasWarnings() {
+		t.Errorf("Expected warnings")
+	}
+	if vc.AggregateError() != nil {
+		t.Errorf("Expected no aggregate error for warnings only")
+	}
+
+	vc.Required("", "Name", "", false)
+	if !vc.HasErrors() {
+		t.Errorf("Expected an error")
+	}
+
+	warnings := vc.Warnings()
+	if len(warnings) != 2 || warnings[1].Field != "profile.bio" || warnings[1].Code != CodeMaxLength {
+		t.Errorf("Unexpected warnings: %v", warnings)
+	}
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Field != "Name" || errs[0].Severity != SeverityError {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+	issues := vc.Issues()
+	wantSeverities := []Severity{SeverityWarning, SeverityInfo, SeverityWarning, SeverityError}
+	if len(issues) != len(wantSeverities) {
+		t.Fatalf("Expected issue count: %v, got: %v", len(wantSeverities), len(issues))
+	}
+	for i, severity := range wantSeverities {
+		if issues[i].Severity != severity {
+			t.Errorf("Expected severity: %v, got: %v", severity, issues[i].Severity)
+		}
+	}
+
+	aggErr := vc.AggregateError().(*ValidationAggregateError)
+	if len(aggErr.Errors) != 1 || strings.Contains(aggErr.Error(), "weak") {
+		t.Errorf("Expected the aggregate error to contain only errors, got: %v", aggErr)
+	}
+	if len(vc.ProblemDetails().InvalidParams) != 1 {
+		t.Errorf("Expected problem details to contain only errors")
+	}
+}
+
+func TestSeverityLimits(t *testing.T) {
+	vc := NewValidationContext(WithFailFast())
+	vc.AddWarning("Password", "Password is weak")
+	vc.AsWarning().ValidateMinLength("a", "Name", 2, "")
+	if vc.ShouldSkip("Name") {
+		t.Errorf("Expected warnings not to trigger fail-fast")
+	}
+	vc.ValidateMinLength("a", "Name", 2, "")
+	if len(vc.Errors()) != 1 || len(vc.Warnings()) != 2 {
+		t.Errorf("Unexpected errors: %v, warnings: %v", vc.Errors(), vc.Warnings())
+	}
+
+	vc = NewValidationContext(WithMaxErrors(1))
+	vc.AddError("Name", "Name is required")
+	vc.AddWarning("Password", "Password is weak")
+	vc.AddInfo("Nickname", "Nickname is unused")
+	if vc.Truncated() != 0 || strings.Contains(vc.FormatErrors(), "more") {
+		t.Errorf("Expected discarded warnings not to count as truncated errors, got: %v", vc.Truncated())
+	}
+
+	vc = NewValidationContext()
+	vc.AsWarning().Field("Password", "abc").Bail().MinLength(8).ContainsNumber()
+	if len(vc.Warnings()) != 2 {
+		t.Errorf("Expected warnings not to stop a chain in bail mode, got: %v", vc.Warnings())
+	}
+}
+
+func TestSeverityJSON(t *testing.T) {
+	b, err := json.Marshal(ValidationError{Field: "Password", Message: "weak", Severity: SeverityWarning})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := `{"field":"Password","message":"weak","severity":"warning"}`; string(b) != want {
+		t.Errorf("Expected: %v, got: %v", want, string(b))
+	}
+
+	var decoded ValidationError
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded.Severity != SeverityWarning {
+		t.Errorf("Expected severity to round-trip, got: %v, %v", decoded.Severity, err)
+	}
+	if err := json.Unmarshal([]byte(`{"severity":"fatal"}`), &decoded); err == nil {
+		t.Errorf("Expected an error for an unknown severity")
+	}
+}
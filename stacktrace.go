@@ -0,0 +1,86 @@
+package validationcontext
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// StackTraceMode controls how stack traces are captured when an error is added.
+type StackTraceMode int
+
+const (
+	// StackTraceFull captures every frame outside this package.
+	StackTraceFull StackTraceMode = iota
+	// StackTraceCaller captures only the first frame outside this package,
+	// i.e. the domain code that called the validator.
+	StackTraceCaller
+	// StackTraceDisabled captures nothing, which avoids the cost in hot paths.
+	StackTraceDisabled
+)
+
+// StackFrame is a single frame of a captured stack trace.
+type StackFrame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// String renders the frame like runtime.Stack does.
+func (f StackFrame) String() string {
+	return fmt.Sprintf("%s\n\t%s:%d", f.Function, f.File, f.Line)
+}
+
+// packagePrefix is the prefix of the function names of this package,
+// e.g. "github.com/take0fit/validationcontext.".
+var packagePrefix = func() string {
+	name := runtime.FuncForPC(reflect.ValueOf(formatFrames).Pointer()).Name()
+	return name[:strings.LastIndex(name, ".")+1]
+}()
+
+// captureFrames returns the frames of the calling goroutine according to mode,
+// skipping the frames of this package. Frames of the package tests are kept.
+func captureFrames(mode StackTraceMode) []StackFrame {
+	if mode == StackTraceDisabled {
+		return nil
+	}
+
+	pcs := make([]uintptr, 32)
+	for {
+		n := runtime.Callers(2, pcs)
+		if n < len(pcs) {
+			pcs = pcs[:n]
+			break
+		}
+		pcs = make([]uintptr, len(pcs)*2)
+	}
+
+	var stack []StackFrame
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if !isInternalFrame(frame) {
+			stack = append(stack, StackFrame{Function: frame.Function, File: frame.File, Line: frame.Line})
+			if mode == StackTraceCaller {
+				return stack
+			}
+		}
+		if !more {
+			return stack
+		}
+	}
+}
+
+func isInternalFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, packagePrefix) && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// formatFrames renders the frames as a single string, one frame per entry.
+func formatFrames(frames []StackFrame) string {
+	lines := make([]string, len(frames))
+	for i, frame := range frames {
+		lines[i] = frame.String()
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,73 @@
+package validationcontext
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestStackTraceModes(t *testing.T) {
+	const caller = "github.com/take0fit/validationcontext.TestStackTraceModes"
+
+	tests := []struct {
+		name       string
+		mode       StackTraceMode
+		wantFrames int // -1 means at least two frames
+	}{
+		{"Full", StackTraceFull, -1},
+		{"Caller", StackTraceCaller, 1},
+		{"Disabled", StackTraceDisabled, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext(WithStackTrace(tt.mode))
+			vc.Scope("user").ValidateMinLength("a", "name", 2, "")
+			err := vc.Errors()[0]
+
+			switch {
+			case tt.wantFrames == -1 && len(err.Frames) < 2:
+				t.Fatalf("Expected at least 2 frames, got: %v", len(err.Frames))
+			case tt.wantFrames >= 0 && len(err.Frames) != tt.wantFrames:
+				t.Fatalf("Expected frame count: %v, got: %v", tt.wantFrames, len(err.Frames))
+			}
+			if tt.wantFrames == 0 {
+				if err.StackTrace != "" {
+					t.Errorf("Expected an empty stack trace, got: %v", err.StackTrace)
+				}
+				return
+			}
+
+			first := err.Frames[0]
+			if !strings.HasPrefix(first.Function, caller) {
+				t.Errorf("Expected the first frame in %s, got: %s", caller, first.Function)
+			}
+			if !strings.HasSuffix(first.File, "stacktrace_test.go") || first.Line == 0 {
+				t.Errorf("Unexpected frame location: %s:%d", first.File, first.Line)
+			}
+			if !strings.HasPrefix(err.StackTrace, first.String()) {
+				t.Errorf("Expected the stack trace to start with %q, got: %q", first.String(), err.StackTrace)
+			}
+			for _, frame := range err.Frames {
+				if strings.HasPrefix(frame.Function, packagePrefix) && !strings.HasSuffix(frame.File, "_test.go") {
+					t.Errorf("Expected internal frames to be skipped, got: %s", frame.Function)
+				}
+			}
+		})
+	}
+}
+
+func TestStackTraceInheritedByViews(t *testing.T) {
+	vc := NewValidationContext(WithStackTrace(StackTraceDisabled))
+	vc.Scope("items").Index(0).Required("", "name", "", false)
+	vc.Go(func(vc *ValidationContext) {
+		vc.Required("", "name", "", false)
+	})
+	vc.Wait(context.Background())
+
+	for _, err := range vc.Errors() {
+		if len(err.Frames) != 0 || err.StackTrace != "" {
+			t.Errorf("Expected no stack trace for %s, got: %v", err.Field, err.StackTrace)
+		}
+	}
+}
@@ -0,0 +1,379 @@
+package validationcontext
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Limits applied by ValidateArchive when the corresponding constraint is zero,
+// so that zip bombs are rejected by default.
+const (
+	DefaultMaxArchiveSize      = 256 << 20
+	DefaultMaxCompressionRatio = 100
+)
+
+// ArchiveConstraints describes the archives accepted by ValidateArchive.
+// MaxEntries and MaxDepth are not enforced at zero, and without
+// AllowedExtensions entries may have any extension.
+type ArchiveConstraints struct {
+	// MaxEntries caps the number of entries, including those of nested archives.
+	MaxEntries int
+	// MaxTotalSize caps the uncompressed size of all entries. Entries are
+	// decompressed to measure their actual size, and reading stops once the
+	// limit is exceeded. Zero means DefaultMaxArchiveSize, and a negative
+	// value removes the cap.
+	MaxTotalSize int64
+	// MaxCompressionRatio caps the ratio of uncompressed to compressed size,
+	// of each zip entry and of the whole archive. Zero means
+	// DefaultMaxCompressionRatio, and a negative value removes the cap.
+	MaxCompressionRatio float64
+	// MaxDepth is the number of levels of nested archives that are inspected.
+	// With zero, nested archives are treated as regular entries.
+	MaxDepth int
+	// AllowedExtensions lists the extensions accepted for entries, e.g. ".pdf".
+	// The comparison is case-insensitive.
+	AllowedExtensions []string
+}
+
+// withDefaults returns c with the default limits in place of zero ones.
+func (c ArchiveConstraints) withDefaults() ArchiveConstraints {
+	if c.MaxTotalSize == 0 {
+		c.MaxTotalSize = DefaultMaxArchiveSize
+	}
+	if c.MaxCompressionRatio == 0 {
+		c.MaxCompressionRatio = DefaultMaxCompressionRatio
+	}
+	return c
+}
+
+// ValidateArchive checks a zip, tar or tar.gz archive, detected from its
+// content, against the constraints. Entry names with "..", absolute paths or
+// drive letters are rejected to prevent zip-slip, as are symbolic and hard
+// links.
+//
+// Errors about the whole archive are reported on field, and errors about an
+// entry on the entry, e.g. "bundle[docs/entry.pdf]" or, for a nested archive,
+// "bundle[inner.zip][entry.pdf]". The file offset is not changed.
+func (vc *ValidationContext) ValidateArchive(file *os.File, field string, constraints ArchiveConstraints, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	info, err := file.Stat()
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileStat, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	vc.validateArchive(file, info.Size(), field, constraints.withDefaults(), errMsg)
+}
+
+// ValidateUploadArchive checks that the upload is an archive satisfying the
+// constraints, see ValidateArchive. Content of unknown size is read into
+// memory up to MaxTotalSize bytes, and is reported as too large beyond it;
+// it is refused if MaxTotalSize is negative.
+func (vc *ValidationContext) ValidateUploadArchive(u *Upload, field string, constraints ArchiveConstraints, errMsg string) {
+	if vc.ShouldSkip(field) || u == nil {
+		return
+	}
+	constraints = constraints.withDefaults()
+	if u.size < 0 && constraints.MaxTotalSize <= 0 {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": errUnknownArchiveSize.Error()})
+		return
+	}
+	size, err := u.sizeAtMost(constraints.MaxTotalSize)
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if u.size < 0 && size > constraints.MaxTotalSize {
+		vc.AddErrorCode(field, CodeArchiveSize, errMsg, map[string]interface{}{
+			"max":    constraints.MaxTotalSize,
+			"max_mb": constraints.MaxTotalSize / (1024 * 1024),
+		})
+		return
+	}
+	r, _, release, err := u.readerAt()
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	defer release()
+	vc.validateArchive(r, size, field, constraints, errMsg)
+}
+
+func (vc *ValidationContext) validateArchive(r io.ReaderAt, size int64, field string, c ArchiveConstraints, errMsg string) {
+	inspector := &archiveInspector{vc: vc, field: field, c: c, errMsg: errMsg, size: size}
+	if c.MaxCompressionRatio > 0 && size > 0 {
+		inspector.maxRatioTotal = ratioLimit(size, c.MaxCompressionRatio)
+	}
+	inspector.inspect(vc.Scope(field), vc, field, r, size, 0)
+}
+
+// maxNestedArchiveSize caps the size of a nested archive, which is buffered in
+// memory to be inspected.
+const maxNestedArchiveSize = 32 << 20
+
+var (
+	// errArchiveLimit stops the inspection once a limit on the whole archive is exceeded.
+	errArchiveLimit       = errors.New("validationcontext: archive limit exceeded")
+	errUnknownArchiveSize = errors.New("archive of unknown size requires a MaxTotalSize limit")
+)
+
+// archiveInspector walks an archive and its nested archives.
+// vc and field locate the errors about the limits of the whole archive.
+type archiveInspector struct {
+	vc      *ValidationContext
+	field   string
+	c       ArchiveConstraints
+	errMsg  string
+	entries int
+	total   int64
+	// size is the size of the whole archive, and maxRatioTotal the total
+	// uncompressed size allowed by MaxCompressionRatio, or 0.
+	size          int64
+	maxRatioTotal int64
+}
+
+// inspect walks the archive read from r. entries is the view on which entry
+// errors are reported, and parent and field locate errors about the archive.
+// It reports whether the walk completed without exceeding a limit.
+func (a *archiveInspector) inspect(entries, parent *ValidationContext, field string, r io.ReaderAt, size int64, depth int) bool {
+	head := make([]byte, sniffLen)
+	n, err := r.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		parent.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return false
+	}
+
+	switch contentType := DetectContentType(head[:n]); contentType {
+	case "application/zip":
+		err = a.walkZip(entries, r, size, depth)
+	case "application/gzip":
+		var gz *gzip.Reader
+		if gz, err = gzip.NewReader(io.NewSectionReader(r, 0, size)); err == nil {
+			err = a.walkTar(entries, tar.NewReader(gz), depth)
+		}
+	case "application/x-tar":
+		err = a.walkTar(entries, tar.NewReader(io.NewSectionReader(r, 0, size)), depth)
+	default:
+		err = fmt.Errorf("unsupported archive type %s", contentType)
+	}
+
+	switch {
+	case errors.Is(err, errArchiveLimit):
+		return false
+	case err != nil:
+		parent.AddErrorCode(field, CodeArchiveFormat, a.errMsg, map[string]interface{}{"error": err.Error()})
+		return false
+	}
+	return true
+}
+
+func (a *archiveInspector) walkZip(entries *ValidationContext, r io.ReaderAt, size int64, depth int) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		if read, err := a.checkEntry(entries, f.Name, f.FileInfo().IsDir()); !read {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if f.Mode()&fs.ModeSymlink != 0 {
+			entries.Key(f.Name).AddErrorCode("", CodeArchivePath, a.errMsg, map[string]interface{}{"entry": f.Name})
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			entries.Key(f.Name).AddErrorCode("", CodeArchiveFormat, a.errMsg, map[string]interface{}{"error": err.Error()})
+			continue
+		}
+		err = a.readEntry(entries, f.Name, rc, int64(f.CompressedSize64), depth)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *archiveInspector) walkTar(entries *ValidationContext, tr *tar.Reader, depth int) error {
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if read, err := a.checkEntry(entries, hdr.Name, hdr.Typeflag == tar.TypeDir); !read {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if hdr.Typeflag == tar.TypeSymlink || hdr.Typeflag == tar.TypeLink {
+			entries.Key(hdr.Name).AddErrorCode("", CodeArchivePath, a.errMsg, map[string]interface{}{"entry": hdr.Name})
+			continue
+		}
+		if err := a.readEntry(entries, hdr.Name, tr, -1, depth); err != nil {
+			return err
+		}
+	}
+}
+
+// checkEntry counts an entry and checks its name. It reports whether its
+// content should be read, and returns errArchiveLimit once MaxEntries is exceeded.
+func (a *archiveInspector) checkEntry(entries *ValidationContext, name string, isDir bool) (bool, error) {
+	a.entries++
+	if a.c.MaxEntries > 0 && a.entries > a.c.MaxEntries {
+		a.vc.AddErrorCode(a.field, CodeArchiveEntries, a.errMsg, map[string]interface{}{"max": a.c.MaxEntries})
+		return false, errArchiveLimit
+	}
+	entry := entries.Key(name)
+	if !isSafeEntryName(name) {
+		entry.AddErrorCode("", CodeArchivePath, a.errMsg, map[string]interface{}{"entry": name})
+		return false, nil
+	}
+	if isDir {
+		return false, nil
+	}
+	if len(a.c.AllowedExtensions) > 0 && !hasExtension(name, a.c.AllowedExtensions) {
+		entry.AddErrorCode("", CodeArchiveExtension, a.errMsg, map[string]interface{}{
+			"entry":      name,
+			"extensions": a.c.AllowedExtensions,
+		})
+		return false, nil
+	}
+	return true, nil
+}
+
+// readEntry decompresses an entry to measure its size, and inspects it if it
+// is a nested archive. Reading stops as soon as a size or ratio limit is
+// exceeded, so that a zip bomb is never fully decompressed.
+func (a *archiveInspector) readEntry(entries *ValidationContext, name string, r io.Reader, compressed int64, depth int) error {
+	nested := a.c.MaxDepth > 0 && isArchiveName(name)
+	limit := int64(math.MaxInt64)
+	if a.c.MaxTotalSize > 0 {
+		limit = a.c.MaxTotalSize - a.total + 1
+	}
+	if a.maxRatioTotal > 0 {
+		limit = min(limit, a.maxRatioTotal-a.total+1)
+	}
+	maxEntry := int64(math.MaxInt64)
+	if a.c.MaxCompressionRatio > 0 && compressed > 0 {
+		maxEntry = ratioLimit(compressed, a.c.MaxCompressionRatio)
+		limit = min(limit, maxEntry+1)
+	}
+	if nested {
+		limit = min(limit, maxNestedArchiveSize+1)
+	}
+
+	var content bytes.Buffer
+	var sink io.Writer = io.Discard
+	if nested {
+		sink = &content
+	}
+	n, err := io.CopyN(sink, r, limit)
+	a.total += n
+	entry := entries.Key(name)
+	if err != nil && err != io.EOF {
+		entry.AddErrorCode("", CodeArchiveFormat, a.errMsg, map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+	if a.c.MaxTotalSize > 0 && a.total > a.c.MaxTotalSize {
+		a.vc.AddErrorCode(a.field, CodeArchiveSize, a.errMsg, map[string]interface{}{
+			"max":    a.c.MaxTotalSize,
+			"max_mb": a.c.MaxTotalSize / (1024 * 1024),
+		})
+		return errArchiveLimit
+	}
+	if n > maxEntry {
+		entry.AddErrorCode("", CodeArchiveRatio, a.errMsg, map[string]interface{}{
+			"ratio": math.Round(float64(n)/float64(compressed)*100) / 100,
+			"max":   a.c.MaxCompressionRatio,
+		})
+		return nil
+	}
+	if a.maxRatioTotal > 0 && a.total > a.maxRatioTotal {
+		a.vc.AddErrorCode(a.field, CodeArchiveRatio, a.errMsg, map[string]interface{}{
+			"ratio": math.Round(float64(a.total)/float64(a.size)*100) / 100,
+			"max":   a.c.MaxCompressionRatio,
+		})
+		return errArchiveLimit
+	}
+
+	if !nested {
+		return nil
+	}
+	if n > maxNestedArchiveSize {
+		entry.AddErrorCode("", CodeArchiveSize, a.errMsg, map[string]interface{}{
+			"max":    maxNestedArchiveSize,
+			"max_mb": maxNestedArchiveSize / (1024 * 1024),
+		})
+		return nil
+	}
+	if depth+1 > a.c.MaxDepth {
+		entry.AddErrorCode("", CodeArchiveDepth, a.errMsg, map[string]interface{}{"max": a.c.MaxDepth})
+		return nil
+	}
+	if !a.inspect(entry, entry, "", bytes.NewReader(content.Bytes()), int64(content.Len()), depth+1) && a.limitExceeded() {
+		return errArchiveLimit
+	}
+	return nil
+}
+
+func (a *archiveInspector) limitExceeded() bool {
+	return (a.c.MaxEntries > 0 && a.entries > a.c.MaxEntries) ||
+		(a.c.MaxTotalSize > 0 && a.total > a.c.MaxTotalSize) ||
+		(a.maxRatioTotal > 0 && a.total > a.maxRatioTotal)
+}
+
+// ratioLimit returns the uncompressed size allowed for size compressed bytes.
+func ratioLimit(size int64, ratio float64) int64 {
+	if limit := float64(size) * ratio; limit < math.MaxInt64/2 {
+		return int64(limit)
+	}
+	return math.MaxInt64 / 2
+}
+
+// isSafeEntryName reports whether an entry name stays within the extraction directory.
+func isSafeEntryName(name string) bool {
+	name = strings.ReplaceAll(name, `\`, "/")
+	if name == "" || strings.HasPrefix(name, "/") || filepath.VolumeName(name) != "" ||
+		(len(name) >= 2 && name[1] == ':') {
+		return false
+	}
+	for _, part := range strings.Split(path.Clean(name), "/") {
+		if part == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func isArchiveName(name string) bool {
+	return hasExtension(name, []string{".zip", ".tar", ".gz", ".tgz"})
+}
+
+func hasExtension(name string, extensions []string) bool {
+	ext := strings.ToLower(path.Ext(strings.ReplaceAll(name, `\`, "/")))
+	for _, allowed := range extensions {
+		if ext == strings.ToLower(allowed) {
+			return true
+		}
+	}
+	return false
+}
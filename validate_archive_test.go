@@ -0,0 +1,252 @@
+package validationcontext
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/fs"
+	"testing"
+)
+
+type testArchiveEntry struct {
+	name    string
+	content []byte
+}
+
+func buildTestZip(t *testing.T, entries ...testArchiveEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, e := range entries {
+		f, err := w.Create(e.name)
+		if err != nil {
+			t.Fatalf("Failed to create zip entry: %v", err)
+		}
+		f.Write(e.content)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func buildTestZipSymlink(t *testing.T, name, target string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	header := &zip.FileHeader{Name: name}
+	header.SetMode(fs.ModeSymlink | 0o777)
+	f, err := w.CreateHeader(header)
+	if err != nil {
+		t.Fatalf("Failed to create zip entry: %v", err)
+	}
+	f.Write([]byte(target))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func buildTestTarGz(t *testing.T, entries ...testArchiveEntry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	w := tar.NewWriter(gz)
+	for _, e := range entries {
+		if err := w.WriteHeader(&tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("Failed to write tar header: %v", err)
+		}
+		w.Write(e.content)
+	}
+	w.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+func TestValidateArchive(t *testing.T) {
+	pdf := testArchiveEntry{"docs/entry.pdf", []byte("%PDF-1.7 document")}
+	inner := buildTestZip(t, testArchiveEntry{"doc.exe", []byte("MZ")})
+	constraints := ArchiveConstraints{
+		MaxEntries:          10,
+		MaxTotalSize:        1 << 20,
+		MaxCompressionRatio: 100,
+		MaxDepth:            1,
+		AllowedExtensions:   []string{".pdf", ".zip"},
+	}
+
+	tests := []struct {
+		name        string
+		content     []byte
+		constraints ArchiveConstraints
+		wantFields  []string
+		wantCodes   []string
+	}{
+		{"ValidZip", buildTestZip(t, pdf, testArchiveEntry{"docs/", nil}), constraints, nil, nil},
+		{"ValidTarGz", buildTestTarGz(t, pdf), constraints, nil, nil},
+		{"ZipSlip", buildTestZip(t, pdf, testArchiveEntry{"../evil.pdf", nil}, testArchiveEntry{"/etc/passwd.pdf", nil}), constraints,
+			[]string{"bundle[../evil.pdf]", "bundle[/etc/passwd.pdf]"}, []string{CodeArchivePath, CodeArchivePath}},
+		{"TarSlip", buildTestTarGz(t, testArchiveEntry{"a/../../evil.pdf", nil}), constraints,
+			[]string{"bundle[a/../../evil.pdf]"}, []string{CodeArchivePath}},
+		{"Extension", buildTestZip(t, pdf, testArchiveEntry{"run.EXE", []byte("MZ")}), constraints,
+			[]string{"bundle[run.EXE]"}, []string{CodeArchiveExtension}},
+		{"Nested", buildTestZip(t, testArchiveEntry{"inner.zip", inner}), constraints,
+			[]string{"bundle[inner.zip][doc.exe]"}, []string{CodeArchiveExtension}},
+		{"TooDeep", buildTestZip(t, testArchiveEntry{"outer.zip", buildTestZip(t, testArchiveEntry{"inner.zip", inner})}), constraints,
+			[]string{"bundle[outer.zip][inner.zip]"}, []string{CodeArchiveDepth}},
+		{"NestedInvalid", buildTestZip(t, testArchiveEntry{"inner.zip", []byte("not a zip")}), constraints,
+			[]string{"bundle[inner.zip]"}, []string{CodeArchiveFormat}},
+		{"TooManyEntries", buildTestZip(t, pdf, pdf, pdf), ArchiveConstraints{MaxEntries: 2},
+			[]string{"bundle"}, []string{CodeArchiveEntries}},
+		{"TooLarge", buildTestZip(t, testArchiveEntry{"a.pdf", make([]byte, 600)}, testArchiveEntry{"b.pdf", make([]byte, 600)}), ArchiveConstraints{MaxTotalSize: 1000},
+			[]string{"bundle"}, []string{CodeArchiveSize}},
+		{"ZipSymlink", buildTestZipSymlink(t, "link.pdf", "/etc/passwd"), constraints,
+			[]string{"bundle[link.pdf]"}, []string{CodeArchivePath}},
+		{"Bomb", buildTestZip(t, testArchiveEntry{"zeros.pdf", make([]byte, 1<<20)}), constraints,
+			[]string{"bundle[zeros.pdf]"}, []string{CodeArchiveRatio}},
+		{"TarGzBomb", buildTestTarGz(t, testArchiveEntry{"zeros.pdf", make([]byte, 1<<20)}), constraints,
+			[]string{"bundle"}, []string{CodeArchiveRatio}},
+		{"NotAnArchive", []byte("%PDF-1.7"), constraints, []string{"bundle"}, []string{CodeArchiveFormat}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := createTempContentFile(t, "bundle*.zip", tt.content)
+
+			for _, validate := range []func(vc *ValidationContext){
+				func(vc *ValidationContext) { vc.ValidateArchive(file, "bundle", tt.constraints, "") },
+				func(vc *ValidationContext) {
+					vc.ValidateUploadArchive(BytesUpload("bundle.zip", tt.content), "bundle", tt.constraints, "")
+				},
+			} {
+				vc := NewValidationContext()
+				validate(vc)
+
+				errs := vc.Errors()
+				if len(errs) != len(tt.wantCodes) {
+					t.Fatalf("Expected codes: %v, got: %v", tt.wantCodes, errs)
+				}
+				for i := range errs {
+					if errs[i].Field != tt.wantFields[i] || errs[i].Code != tt.wantCodes[i] {
+						t.Errorf("Expected %v (%v), got: %v (%v)", tt.wantFields[i], tt.wantCodes[i], errs[i].Field, errs[i].Code)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValidateArchiveStopsAtRatio(t *testing.T) {
+	content := buildTestZip(t, testArchiveEntry{"zeros.pdf", make([]byte, 8<<20)})
+	vc := NewValidationContext()
+	vc.ValidateUploadArchive(BytesUpload("bundle.zip", content), "bundle", ArchiveConstraints{MaxCompressionRatio: 10}, "")
+
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Code != CodeArchiveRatio {
+		t.Fatalf("Expected a compression ratio error, got: %v", errs)
+	}
+	// The entry is read only up to the ratio limit, so the reported ratio
+	// stays close to it instead of the actual one.
+	if ratio := errs[0].Params["ratio"].(float64); ratio > 11 {
+		t.Errorf("Expected the entry to be read up to the ratio limit, got ratio %v", ratio)
+	}
+}
+
+func TestValidateArchiveDefaultLimits(t *testing.T) {
+	content := buildTestZip(t, testArchiveEntry{"zeros.pdf", make([]byte, 8<<20)})
+
+	vc := NewValidationContext()
+	vc.ValidateUploadArchive(BytesUpload("bundle.zip", content), "bundle", ArchiveConstraints{}, "")
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Code != CodeArchiveRatio || errs[0].Params["max"] != float64(DefaultMaxCompressionRatio) {
+		t.Fatalf("Expected the default compression ratio to apply, got: %v", errs)
+	}
+
+	vc = NewValidationContext()
+	vc.ValidateUploadArchive(BytesUpload("bundle.zip", content), "bundle", ArchiveConstraints{MaxCompressionRatio: -1, MaxTotalSize: 1 << 20}, "")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeArchiveSize {
+		t.Fatalf("Expected a size error, got: %v", errs)
+	}
+
+	vc = NewValidationContext()
+	vc.ValidateUploadArchive(BytesUpload("bundle.zip", content), "bundle", ArchiveConstraints{MaxCompressionRatio: -1, MaxTotalSize: -1}, "")
+	if vc.HasErrors() {
+		t.Fatalf("Expected no errors without limits, got: %v", vc.Errors())
+	}
+}
+
+func TestValidateUploadArchiveUnknownSize(t *testing.T) {
+	content := buildTestZip(t, testArchiveEntry{"a.pdf", []byte("%PDF-1.7")})
+
+	tests := []struct {
+		name        string
+		constraints ArchiveConstraints
+		wantCode    string
+	}{
+		{"WithinMaxTotalSize", ArchiveConstraints{MaxTotalSize: 1 << 20}, ""},
+		{"LargerThanMaxTotalSize", ArchiveConstraints{MaxTotalSize: int64(len(content)) - 1}, CodeArchiveSize},
+		{"DefaultMaxTotalSize", ArchiveConstraints{MaxEntries: 10}, ""},
+		{"NoMaxTotalSize", ArchiveConstraints{MaxTotalSize: -1}, CodeFileRead},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidateUploadArchive(ReaderUpload("bundle.zip", bytes.NewReader(content)), "bundle", tt.constraints, "")
+
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Code != tt.wantCode {
+				t.Fatalf("Expected %v, got: %v", tt.wantCode, errs)
+			}
+		})
+	}
+}
+
+func TestValidateArchiveNestedSize(t *testing.T) {
+	// The inner archive is stored uncompressed, so that it is larger than
+	// maxNestedArchiveSize while the outer archive stays small.
+	var inner bytes.Buffer
+	w := zip.NewWriter(&inner)
+	f, err := w.CreateHeader(&zip.FileHeader{Name: "big.pdf", Method: zip.Store})
+	if err != nil {
+		t.Fatalf("Failed to create zip entry: %v", err)
+	}
+	f.Write(make([]byte, maxNestedArchiveSize))
+	w.Close()
+	content := buildTestZip(t, testArchiveEntry{"inner.zip", inner.Bytes()})
+	vc := NewValidationContext()
+	vc.ValidateUploadArchive(BytesUpload("bundle.zip", content), "bundle", ArchiveConstraints{MaxDepth: 1, MaxCompressionRatio: -1}, "")
+
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Field != "bundle[inner.zip]" || errs[0].Code != CodeArchiveSize {
+		t.Fatalf("Expected a size error on the nested archive, got: %v", errs)
+	}
+}
+
+func TestIsSafeEntryName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"docs/entry.pdf", true},
+		{"docs/../entry.pdf", true},
+		{"..entry.pdf", true},
+		{"../entry.pdf", false},
+		{`..\entry.pdf`, false},
+		{"/etc/passwd", false},
+		{`C:\Windows\evil.dll`, false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isSafeEntryName(tt.name); got != tt.want {
+			t.Errorf("isSafeEntryName(%q): expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package validationcontext
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// When runs fn with vc only if cond is true, e.g.
+//
+//	vc.When(billingType == "company", func(vc *validationcontext.ValidationContext) {
+//		vc.Required(invoiceAddress, "InvoiceAddress", "", false)
+//	})
+func (vc *ValidationContext) When(cond bool, fn func(vc *ValidationContext)) {
+	if cond {
+		fn(vc)
+	}
+}
+
+// RequiredIf requires value when otherValue equals expected.
+// Pointers are dereferenced before the comparison.
+func (vc *ValidationContext) RequiredIf(value interface{}, field, otherField string, otherValue, expected interface{}, errMsg string) {
+	vc.requiredWhen(value, field, equalValues(otherValue, expected), CodeRequiredIf,
+		map[string]interface{}{"other": otherField, "value": expected}, errMsg)
+}
+
+// RequiredUnless requires value unless otherValue equals expected.
+// Pointers are dereferenced before the comparison.
+func (vc *ValidationContext) RequiredUnless(value interface{}, field, otherField string, otherValue, expected interface{}, errMsg string) {
+	vc.requiredWhen(value, field, !equalValues(otherValue, expected), CodeRequiredUnless,
+		map[string]interface{}{"other": otherField, "value": expected}, errMsg)
+}
+
+// RequiredWith requires value when otherValue is present, i.e. when Required would accept it.
+func (vc *ValidationContext) RequiredWith(value interface{}, field, otherField string, otherValue interface{}, errMsg string) {
+	vc.requiredWhen(value, field, isPresent(otherValue), CodeRequiredWith,
+		map[string]interface{}{"other": otherField}, errMsg)
+}
+
+// RequiredWithout requires value when otherValue is not present.
+func (vc *ValidationContext) RequiredWithout(value interface{}, field, otherField string, otherValue interface{}, errMsg string) {
+	vc.requiredWhen(value, field, !isPresent(otherValue), CodeRequiredWithout,
+		map[string]interface{}{"other": otherField}, errMsg)
+}
+
+func (vc *ValidationContext) requiredWhen(value interface{}, field string, cond bool, code string, params map[string]interface{}, errMsg string) {
+	if !cond || vc.ShouldSkip(field) {
+		return
+	}
+	if !isPresent(value) {
+		vc.AddErrorCode(field, code, errMsg, params)
+	}
+}
+
+// isPresent reports whether Required would accept the value.
+func isPresent(value interface{}) bool {
+	value, isNil := indirect(value)
+	return !isNil && !isEmpty(value)
+}
+
+// equalValues compares two values after dereferencing pointers. A value of
+// the same kind is converted to the type of the other, e.g. a string to a
+// named string type; values of different kinds are compared by their
+// formatted form, as struct tag parameters are.
+func equalValues(a, b interface{}) bool {
+	a, aNil := indirect(a)
+	b, bNil := indirect(b)
+	if aNil || bNil {
+		return aNil == bNil
+	}
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case av.Type() == bv.Type():
+		return reflect.DeepEqual(a, b)
+	case av.Kind() == bv.Kind() && bv.Type().ConvertibleTo(av.Type()):
+		return reflect.DeepEqual(a, bv.Convert(av.Type()).Interface())
+	default:
+		return fmt.Sprint(a) == fmt.Sprint(b)
+	}
+}
@@ -0,0 +1,159 @@
+package validationcontext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWhen(t *testing.T) {
+	tests := []struct {
+		name           string
+		cond           bool
+		expectErrCount int
+	}{
+		{"ConditionMet", true, 1},
+		{"ConditionNotMet", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.Scope("billing").When(tt.cond, func(vc *ValidationContext) {
+				vc.Required("", "invoice_address", "", false)
+			})
+			if len(vc.Errors()) != tt.expectErrCount {
+				t.Fatalf("Expected error count: %v, got: %v", tt.expectErrCount, len(vc.Errors()))
+			}
+			if tt.expectErrCount > 0 && vc.Errors()[0].Field != "billing.invoice_address" {
+				t.Errorf("Expected field: billing.invoice_address, got: %v", vc.Errors()[0].Field)
+			}
+		})
+	}
+}
+
+type billingType string
+
+func TestConditionalRequired(t *testing.T) {
+	company := "company"
+	tests := []struct {
+		name     string
+		validate func(vc *ValidationContext)
+		wantCode string
+	}{
+		{"RequiredIfMatched", func(vc *ValidationContext) {
+			vc.RequiredIf("", "InvoiceAddress", "BillingType", "company", "company", "")
+		}, CodeRequiredIf},
+		{"RequiredIfPointer", func(vc *ValidationContext) {
+			vc.RequiredIf("", "InvoiceAddress", "BillingType", &company, "company", "")
+		}, CodeRequiredIf},
+		{"RequiredIfNotMatched", func(vc *ValidationContext) {
+			vc.RequiredIf("", "InvoiceAddress", "BillingType", "person", "company", "")
+		}, ""},
+		{"RequiredIfNamedType", func(vc *ValidationContext) {
+			vc.RequiredIf("", "InvoiceAddress", "BillingType", billingType("company"), "company", "")
+		}, CodeRequiredIf},
+		{"RequiredIfOtherKind", func(vc *ValidationContext) {
+			vc.RequiredIf("", "InvoiceAddress", "Plan", int64(2), 2, "")
+		}, CodeRequiredIf},
+		{"RequiredIfPresent", func(vc *ValidationContext) {
+			vc.RequiredIf("Tokyo", "InvoiceAddress", "BillingType", "company", "company", "")
+		}, ""},
+		{"RequiredUnlessMatched", func(vc *ValidationContext) { vc.RequiredUnless("", "Reason", "Status", "approved", "approved", "") }, ""},
+		{"RequiredUnlessNotMatched", func(vc *ValidationContext) { vc.RequiredUnless("", "Reason", "Status", "rejected", "approved", "") }, CodeRequiredUnless},
+		{"RequiredUnlessNamedType", func(vc *ValidationContext) {
+			vc.RequiredUnless("", "Reason", "Status", billingType("approved"), "approved", "")
+		}, ""},
+		{"RequiredWithPresent", func(vc *ValidationContext) { vc.RequiredWith("", "CardExpiry", "CardNumber", "4242", "") }, CodeRequiredWith},
+		{"RequiredWithAbsent", func(vc *ValidationContext) { vc.RequiredWith("", "CardExpiry", "CardNumber", (*string)(nil), "") }, ""},
+		{"RequiredWithoutAbsent", func(vc *ValidationContext) { vc.RequiredWithout("", "Phone", "Email", "", "") }, CodeRequiredWithout},
+		{"RequiredWithoutPresent", func(vc *ValidationContext) { vc.RequiredWithout("", "Phone", "Email", "a@example.com", "") }, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			tt.validate(vc)
+
+			var codes []string
+			for _, err := range vc.Errors() {
+				codes = append(codes, err.Code)
+			}
+			var want []string
+			if tt.wantCode != "" {
+				want = []string{tt.wantCode}
+			}
+			if !reflect.DeepEqual(codes, want) {
+				t.Errorf("Expected codes: %v, got: %v", want, codes)
+			}
+		})
+	}
+}
+
+func TestConditionalMessages(t *testing.T) {
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.RequiredIf("", "InvoiceAddress", "BillingType", "company", "company", "")
+	vc.RequiredWithout("", "Phone", "Email", "", "")
+
+	want := []string{
+		"InvoiceAddress is required when BillingType is company.",
+		"Phone is required when Email is not present.",
+	}
+	for i, err := range vc.Errors() {
+		if err.Message != want[i] {
+			t.Errorf("Expected message: %v, got: %v", want[i], err.Message)
+		}
+	}
+}
+
+type conditionalTestBilling string
+
+type conditionalTestRequest struct {
+	BillingType    conditionalTestBilling `json:"billing_type"`
+	InvoiceAddress *string                `json:"invoice_address" validate:"required_if=BillingType company"`
+	Status         string                 `json:"status"`
+	Reason         string                 `json:"reason" validate:"required_unless=Status approved"`
+	Email          string                 `json:"email"`
+	Phone          string                 `json:"phone" validate:"required_without=Email"`
+	CardNumber     string                 `json:"card_number"`
+	CardExpiry     string                 `json:"card_expiry" validate:"required_with=CardNumber,omitempty,year_month"`
+}
+
+func TestValidateStructConditional(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      conditionalTestRequest
+		wantFields []string
+	}{
+		{"AllSatisfied", conditionalTestRequest{BillingType: "person", Status: "approved", Email: "a@example.com"}, nil},
+		{"AllMissing", conditionalTestRequest{BillingType: "company", Status: "rejected", CardNumber: "4242"}, []string{"orders[0].invoice_address", "orders[0].reason", "orders[0].phone", "orders[0].card_expiry"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext(WithLocale(LocaleEn))
+			vc.Scope("orders").Index(0).ValidateStruct(tt.value)
+
+			var fields []string
+			for _, err := range vc.Errors() {
+				fields = append(fields, err.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("Expected fields: %v, got: %v", tt.wantFields, fields)
+			}
+			if len(tt.wantFields) > 0 && vc.Errors()[0].Message != "invoice_address is required when billing_type is company." {
+				t.Errorf("Unexpected message: %v", vc.Errors()[0].Message)
+			}
+		})
+	}
+}
+
+func TestValidateStructConditionalUnknownField(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic, but got none")
+		}
+	}()
+	NewValidationContext().ValidateStruct(struct {
+		Phone string `validate:"required_without=Missing"`
+	}{})
+}
@@ -0,0 +1,114 @@
+package validationcontext
+
+import (
+	"cmp"
+	"time"
+)
+
+// ValidateEqualField checks if the value equals the value of another field,
+// e.g. a password confirmation. The error is reported on field and the
+// message refers to otherField. Pointers are dereferenced before the comparison.
+func (vc *ValidationContext) ValidateEqualField(value interface{}, field string, otherValue interface{}, otherField string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !equalValues(value, otherValue) {
+		vc.AddErrorCode(field, CodeEqualField, errMsg, map[string]interface{}{"other": otherField})
+	}
+}
+
+// ValidateNotEqualField checks if the value differs from the value of another field.
+func (vc *ValidationContext) ValidateNotEqualField(value interface{}, field string, otherValue interface{}, otherField string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if equalValues(value, otherValue) {
+		vc.AddErrorCode(field, CodeNotEqualField, errMsg, map[string]interface{}{"other": otherField})
+	}
+}
+
+// ValidateGreaterThanField checks if the value is greater than the value of another field.
+// It accepts numbers and strings; strings are compared lexically.
+func ValidateGreaterThanField[T cmp.Ordered](vc *ValidationContext, value T, field string, other T, otherField string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value > other) {
+		vc.AddErrorCode(field, CodeGreaterThanField, errMsg, map[string]interface{}{"other": otherField})
+	}
+}
+
+// ValidateGreaterThanOrEqualField checks if the value is greater than or equal to the value of another field.
+func ValidateGreaterThanOrEqualField[T cmp.Ordered](vc *ValidationContext, value T, field string, other T, otherField string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value >= other) {
+		vc.AddErrorCode(field, CodeGreaterThanOrEqualField, errMsg, map[string]interface{}{"other": otherField})
+	}
+}
+
+// ValidateLessThanField checks if the value is less than the value of another field.
+func ValidateLessThanField[T cmp.Ordered](vc *ValidationContext, value T, field string, other T, otherField string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value < other) {
+		vc.AddErrorCode(field, CodeLessThanField, errMsg, map[string]interface{}{"other": otherField})
+	}
+}
+
+// ValidateLessThanOrEqualField checks if the value is less than or equal to the value of another field.
+func ValidateLessThanOrEqualField[T cmp.Ordered](vc *ValidationContext, value T, field string, other T, otherField string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value <= other) {
+		vc.AddErrorCode(field, CodeLessThanOrEqualField, errMsg, map[string]interface{}{"other": otherField})
+	}
+}
+
+// ValidateDateAfterField checks if the date is after the date of another field,
+// both in the format "2006-01-02". Values that cannot be parsed are left to ValidateDate.
+func (vc *ValidationContext) ValidateDateAfterField(value, field, other, otherField, errMsg string) {
+	vc.validateTimeOrder(value, field, other, otherField, "2006-01-02", true, errMsg)
+}
+
+// ValidateDateBeforeField checks if the date is before the date of another field,
+// both in the format "2006-01-02". Values that cannot be parsed are left to ValidateDate.
+func (vc *ValidationContext) ValidateDateBeforeField(value, field, other, otherField, errMsg string) {
+	vc.validateTimeOrder(value, field, other, otherField, "2006-01-02", false, errMsg)
+}
+
+// ValidateDateTimeAfterField checks if the date and time is after that of another field,
+// both in the format "2006-01-02 15:04:05". Values that cannot be parsed are left to ValidateDateTime.
+func (vc *ValidationContext) ValidateDateTimeAfterField(value, field, other, otherField, errMsg string) {
+	vc.validateTimeOrder(value, field, other, otherField, "2006-01-02 15:04:05", true, errMsg)
+}
+
+// ValidateDateTimeBeforeField checks if the date and time is before that of another field,
+// both in the format "2006-01-02 15:04:05". Values that cannot be parsed are left to ValidateDateTime.
+func (vc *ValidationContext) ValidateDateTimeBeforeField(value, field, other, otherField, errMsg string) {
+	vc.validateTimeOrder(value, field, other, otherField, "2006-01-02 15:04:05", false, errMsg)
+}
+
+// validateTimeOrder checks if value is after other, or before it if after is false.
+func (vc *ValidationContext) validateTimeOrder(value, field, other, otherField, layout string, after bool, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return
+	}
+	o, err := time.Parse(layout, other)
+	if err != nil {
+		return
+	}
+	params := map[string]interface{}{"other": otherField}
+	if after && !t.After(o) {
+		vc.AddErrorCode(field, CodeAfterField, errMsg, params)
+	} else if !after && !t.Before(o) {
+		vc.AddErrorCode(field, CodeBeforeField, errMsg, params)
+	}
+}
@@ -0,0 +1,91 @@
+package validationcontext
+
+import (
+	"testing"
+)
+
+func TestCrossFieldValidators(t *testing.T) {
+	password := "secret"
+	tests := []struct {
+		name           string
+		validate       func(vc *ValidationContext)
+		wantCode       string
+		expectErrCount int
+	}{
+		{"EqualFieldMatch", func(vc *ValidationContext) {
+			vc.ValidateEqualField("secret", "PasswordConfirmation", &password, "Password", "")
+		}, "", 0},
+		{"EqualFieldMismatch", func(vc *ValidationContext) {
+			vc.ValidateEqualField("secret!", "PasswordConfirmation", password, "Password", "")
+		}, CodeEqualField, 1},
+		{"NotEqualField", func(vc *ValidationContext) {
+			vc.ValidateNotEqualField("secret", "NewPassword", "secret", "Password", "")
+		}, CodeNotEqualField, 1},
+		{"GreaterThanFieldInt", func(vc *ValidationContext) { ValidateGreaterThanField(vc, 10, "Max", 10, "Min", "") }, CodeGreaterThanField, 1},
+		{"GreaterThanOrEqualFieldInt", func(vc *ValidationContext) { ValidateGreaterThanOrEqualField(vc, 10, "Max", 10, "Min", "") }, "", 0},
+		{"LessThanFieldFloat", func(vc *ValidationContext) { ValidateLessThanField(vc, 1.5, "Discount", 1.0, "Price", "") }, CodeLessThanField, 1},
+		{"LessThanOrEqualFieldString", func(vc *ValidationContext) { ValidateLessThanOrEqualField(vc, "b", "From", "a", "To", "") }, CodeLessThanOrEqualField, 1},
+		{"DateAfterField", func(vc *ValidationContext) {
+			vc.ValidateDateAfterField("2024-04-30", "EndDate", "2024-04-01", "StartDate", "")
+		}, "", 0},
+		{"DateAfterFieldSameDay", func(vc *ValidationContext) {
+			vc.ValidateDateAfterField("2024-04-01", "EndDate", "2024-04-01", "StartDate", "")
+		}, CodeAfterField, 1},
+		{"DateBeforeField", func(vc *ValidationContext) {
+			vc.ValidateDateBeforeField("2024-05-01", "StartDate", "2024-04-30", "EndDate", "")
+		}, CodeBeforeField, 1},
+		{"DateInvalidIsSkipped", func(vc *ValidationContext) {
+			vc.ValidateDateAfterField("2024-13-01", "EndDate", "2024-04-01", "StartDate", "")
+		}, "", 0},
+		{"DateTimeAfterField", func(vc *ValidationContext) {
+			vc.ValidateDateTimeAfterField("2024-04-01 09:00:00", "EndAt", "2024-04-01 10:00:00", "StartAt", "")
+		}, CodeAfterField, 1},
+		{"DateTimeBeforeField", func(vc *ValidationContext) {
+			vc.ValidateDateTimeBeforeField("2024-04-01 09:00:00", "StartAt", "2024-04-01 10:00:00", "EndAt", "")
+		}, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			tt.validate(vc)
+			if len(vc.Errors()) != tt.expectErrCount {
+				t.Fatalf("Expected error count: %v, got: %v", tt.expectErrCount, len(vc.Errors()))
+			}
+			if tt.expectErrCount > 0 && vc.Errors()[0].Code != tt.wantCode {
+				t.Errorf("Expected code: %v, got: %v", tt.wantCode, vc.Errors()[0].Code)
+			}
+		})
+	}
+}
+
+func TestCrossFieldMessages(t *testing.T) {
+	tests := []struct {
+		name      string
+		locale    string
+		validate  func(vc *ValidationContext)
+		wantField string
+		want      string
+	}{
+		{"EqualFieldJa", LocaleJa, func(vc *ValidationContext) {
+			vc.Scope("user").ValidateEqualField("a", "パスワード（確認）", "b", "パスワード", "")
+		}, "user.パスワード（確認）", "パスワード（確認）はパスワードと同じ値を入力してください。"},
+		{"DateAfterFieldEn", LocaleEn, func(vc *ValidationContext) {
+			vc.ValidateDateAfterField("2024-04-01", "EndDate", "2024-04-02", "StartDate", "")
+		}, "EndDate", "EndDate must be after StartDate."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext(WithLocale(tt.locale))
+			tt.validate(vc)
+			err := vc.Errors()[0]
+			if err.Field != tt.wantField {
+				t.Errorf("Expected field: %v, got: %v", tt.wantField, err.Field)
+			}
+			if err.Message != tt.want {
+				t.Errorf("Expected message: %v, got: %v", tt.want, err.Message)
+			}
+		})
+	}
+}
@@ -1,72 +1,85 @@
 package validationcontext
 
 import (
-	"fmt"
 	"time"
 )
 
 // ValidateDate checks if the value is a valid date in the format "2006-01-02".
 func (vc *ValidationContext) ValidateDate(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := time.Parse("2006-01-02", value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効な日付を指定してください。", field))
+		vc.AddErrorCode(field, CodeDate, errMsg, map[string]interface{}{"layout": "2006-01-02"})
 	}
 }
 
 // ValidateYearMonth checks if the value is a valid year and month in the format "2006-01".
 func (vc *ValidationContext) ValidateYearMonth(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := time.Parse("2006-01", value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効な年月を指定してください。", field))
+		vc.AddErrorCode(field, CodeYearMonth, errMsg, map[string]interface{}{"layout": "2006-01"})
 	}
 }
 
 // ValidateYear checks if the value is a valid year.
 func (vc *ValidationContext) ValidateYear(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := time.Parse("2006", value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効な年を指定してください。", field))
+		vc.AddErrorCode(field, CodeYear, errMsg, map[string]interface{}{"layout": "2006"})
 	}
 }
 
 // ValidateMonth checks if the value is a valid month.
 func (vc *ValidationContext) ValidateMonth(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := time.Parse("01", value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効な月を指定してください。", field))
+		vc.AddErrorCode(field, CodeMonth, errMsg, map[string]interface{}{"layout": "01"})
 	}
 }
 
 // ValidateDateTime checks if the value is a valid date and time in the format "2006-01-02 15:04:05".
 func (vc *ValidationContext) ValidateDateTime(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := time.Parse("2006-01-02 15:04:05", value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効な日時を指定してください。", field))
+		vc.AddErrorCode(field, CodeDateTime, errMsg, map[string]interface{}{"layout": "2006-01-02 15:04:05"})
 	}
 }
 
 // ValidateTime checks if the value is a valid time in the format "15:04".
 func (vc *ValidationContext) ValidateTime(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := time.Parse("15:04", value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効な時刻を指定してください。", field))
+		vc.AddErrorCode(field, CodeTime, errMsg, map[string]interface{}{"layout": "15:04"})
+	}
+}
+
+// ValidateTimeBefore checks if the value is strictly before limit.
+func (vc *ValidationContext) ValidateTimeBefore(value time.Time, field string, limit time.Time, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !value.Before(limit) {
+		vc.AddErrorCode(field, CodeBefore, errMsg, map[string]interface{}{"time": limit.Format("2006-01-02 15:04:05")})
+	}
+}
+
+// ValidateTimeAfter checks if the value is strictly after limit.
+func (vc *ValidationContext) ValidateTimeAfter(value time.Time, field string, limit time.Time, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !value.After(limit) {
+		vc.AddErrorCode(field, CodeAfter, errMsg, map[string]interface{}{"time": limit.Format("2006-01-02 15:04:05")})
 	}
 }
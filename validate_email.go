@@ -0,0 +1,198 @@
+package validationcontext
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Length limits of email addresses from RFC 5321, section 4.5.3.1.
+const (
+	maxEmailLocalLength = 64
+	maxEmailLength      = 254
+)
+
+// MXResolver looks up the mail servers of a domain, e.g. *net.Resolver.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// EmailOptions configures ValidateEmailAddress.
+// The zero value accepts bare addresses with a valid domain.
+type EmailOptions struct {
+	// AllowDisplayName accepts addresses with a display name, e.g. "Bob <bob@example.com>".
+	AllowDisplayName bool
+	// BlockedDomains rejects addresses at these domains and their subdomains,
+	// e.g. disposable email providers. The comparison is case-insensitive.
+	BlockedDomains []string
+	// Resolver, if set, requires the domain to have mail servers.
+	Resolver MXResolver
+	// Context, if set, defers the lookup of Resolver to Wait, which runs it with
+	// Async. Otherwise the lookup is done before ValidateEmailAddress returns.
+	Context context.Context
+}
+
+// ValidateEmail checks if the value is a valid email format.
+// It is ValidateEmailAddress with the default EmailOptions.
+func (vc *ValidationContext) ValidateEmail(value string, field string, errMsg string) {
+	vc.ValidateEmailAddress(value, field, EmailOptions{}, errMsg)
+}
+
+// ValidateEmailAddress checks that the value is an email address as parsed by
+// net/mail, including quoted local parts and internationalized domains.
+// Whitespace is only accepted within quotes, or inside an address with a
+// display name.
+// The local part is limited to 64 bytes and the address, with the domain in its
+// ASCII form, to 254 bytes. The domain must have at least two labels of
+// letters, digits and hyphens once converted to ASCII, and a non-numeric
+// top-level domain.
+//
+// Syntax errors are reported with CodeEmail, and the other failures with
+// CodeEmailLength, CodeEmailDisplayName, CodeEmailBlockedDomain and CodeEmailDomain.
+func (vc *ValidationContext) ValidateEmailAddress(value, field string, opts EmailOptions, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	addr, err := mail.ParseAddress(value)
+	if err != nil {
+		vc.AddErrorCode(field, CodeEmail, errMsg, nil)
+		return
+	}
+	if !opts.AllowDisplayName && (addr.Name != "" || strings.HasSuffix(strings.TrimSpace(value), ">")) {
+		vc.AddErrorCode(field, CodeEmailDisplayName, errMsg, nil)
+		return
+	}
+	// net/mail skips the whitespace around an address and between its tokens.
+	if strings.TrimSpace(value) != value || (!opts.AllowDisplayName && hasUnquotedSpace(value)) {
+		vc.AddErrorCode(field, CodeEmail, errMsg, nil)
+		return
+	}
+
+	at := strings.LastIndexByte(addr.Address, '@')
+	local, domain := addr.Address[:at], addr.Address[at+1:]
+	asciiDomain, err := domainToASCII(domain)
+	if err != nil || !isValidHostname(asciiDomain) || !hasTopLevelDomain(asciiDomain) {
+		vc.AddErrorCode(field, CodeEmail, errMsg, nil)
+		return
+	}
+	if len(local) > maxEmailLocalLength || len(local)+1+len(asciiDomain) > maxEmailLength {
+		vc.AddErrorCode(field, CodeEmailLength, errMsg, map[string]interface{}{
+			"max":       maxEmailLength,
+			"max_local": maxEmailLocalLength,
+		})
+		return
+	}
+	for _, blocked := range opts.BlockedDomains {
+		if blocked, err := domainToASCII(blocked); err == nil && (asciiDomain == blocked || strings.HasSuffix(asciiDomain, "."+blocked)) {
+			vc.AddErrorCode(field, CodeEmailBlockedDomain, errMsg, map[string]interface{}{"domain": domain})
+			return
+		}
+	}
+
+	if opts.Resolver != nil {
+		vc.runOrDefer(opts.Context, field, func(ctx context.Context) error {
+			return lookupMailServers(ctx, opts.Resolver, asciiDomain, domain, errMsg)
+		})
+	}
+}
+
+// lookupMailServers returns a ValidationError with CodeEmailDomain if the
+// domain has no mail servers, and the lookup error if the lookup failed.
+func lookupMailServers(ctx context.Context, resolver MXResolver, asciiDomain, domain, errMsg string) error {
+	records, err := resolver.LookupMX(ctx, asciiDomain)
+	var dnsErr *net.DNSError
+	if err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound) {
+		return err
+	}
+	for _, mx := range records {
+		// A null MX record (RFC 7505) declares that the domain accepts no mail.
+		if mx.Host != "." && mx.Host != "" {
+			return nil
+		}
+	}
+	return ValidationError{
+		Code:    CodeEmailDomain,
+		Message: errMsg,
+		Params:  map[string]interface{}{"domain": domain},
+	}
+}
+
+// domainToASCII converts an internationalized domain name to its ASCII form,
+// e.g. "例え.jp" to "xn--r8jz45g.jp", with the IDNA lookup profile. Domains
+// that the profile only accepts by dropping or mapping code points, such as
+// zero-width spaces or full-width letters, and domains with symbols are rejected.
+func domainToASCII(domain string) (string, error) {
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", err
+	}
+	unicodeDomain, err := idna.Lookup.ToUnicode(ascii)
+	if err != nil {
+		return "", err
+	}
+	if unicodeDomain != norm.NFC.String(strings.ToLower(domain)) {
+		return "", fmt.Errorf("validationcontext: domain %q is not in its canonical form", domain)
+	}
+	for _, r := range unicodeDomain {
+		if r != '.' && r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsMark(r) {
+			return "", fmt.Errorf("validationcontext: domain %q contains %U", domain, r)
+		}
+	}
+	return ascii, nil
+}
+
+// hasUnquotedSpace reports whether s contains whitespace outside quoted strings.
+func hasUnquotedSpace(s string) bool {
+	quoted, escaped := false, false
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case !quoted && unicode.IsSpace(r):
+			return true
+		}
+	}
+	return false
+}
+
+// hasTopLevelDomain reports whether name has at least two labels and its last
+// label is not numeric, so that IP addresses and local names are rejected.
+func hasTopLevelDomain(name string) bool {
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 {
+		return false
+	}
+	return strings.Trim(name[i+1:], "0123456789") != ""
+}
+
+// isValidHostname reports whether name is a sequence of dot-separated labels of
+// 1 to 63 letters, digits and hyphens, not starting or ending with a hyphen, of
+// at most 253 characters.
+func isValidHostname(name string) bool {
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
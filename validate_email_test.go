@@ -0,0 +1,202 @@
+package validationcontext
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+)
+
+// stubMXResolver returns fixed MX records per domain.
+type stubMXResolver map[string][]*net.MX
+
+func (r stubMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if name == "timeout.example" {
+		return nil, &net.DNSError{Err: "timeout", Name: name, IsTimeout: true}
+	}
+	records, ok := r[name]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return records, nil
+}
+
+func TestValidateEmailAddress(t *testing.T) {
+	disposable := EmailOptions{BlockedDomains: []string{"mailinator.com", "例え.jp"}}
+	tests := []struct {
+		name     string
+		value    string
+		opts     EmailOptions
+		wantCode string
+	}{
+		{"Valid", "test@example.com", EmailOptions{}, ""},
+		{"Plus", "first.last+tag@sub.example.co.jp", EmailOptions{}, ""},
+		{"QuotedLocalPart", `"john doe"@example.com`, EmailOptions{}, ""},
+		{"IDNDomain", "user@bücher.de", EmailOptions{}, ""},
+		{"UnicodeLocalPart", "用户@例子.广告", EmailOptions{}, ""},
+		{"Invalid", "invalid-email", EmailOptions{}, CodeEmail},
+		{"ConsecutiveDots", "a..b@example.com", EmailOptions{}, CodeEmail},
+		{"SingleLabelDomain", "a@localhost", EmailOptions{}, CodeEmail},
+		{"DomainLiteral", "a@[192.168.0.1]", EmailOptions{}, CodeEmail},
+		{"NumericTLD", "a@1.2.3.4", EmailOptions{}, CodeEmail},
+		{"HyphenLabel", "a@-example.com", EmailOptions{}, CodeEmail},
+		{"Underscore", "a@ex_ample.com", EmailOptions{}, CodeEmail},
+		{"LeadingSpace", " a@example.com", EmailOptions{}, CodeEmail},
+		{"TrailingSpace", "a@example.com ", EmailOptions{}, CodeEmail},
+		{"SpaceBeforeAt", "a @example.com", EmailOptions{}, CodeEmail},
+		{"TrailingSpaceWithDisplayName", "Bob <bob@example.com> ", EmailOptions{AllowDisplayName: true}, CodeEmail},
+		{"BidiOverride", "a@\u202eevil.com", EmailOptions{}, CodeEmail},
+		{"ZeroWidthSpace", "a@ex\u200bample.com", EmailOptions{}, CodeEmail},
+		{"Symbol", "a@\u2603.com", EmailOptions{}, CodeEmail},
+		{"LocalTooLong", strings.Repeat("a", 65) + "@example.com", EmailOptions{}, CodeEmailLength},
+		{"TooLong", "a@" + strings.Repeat("abcdefghi.", 25) + "com", EmailOptions{}, CodeEmailLength},
+		{"DisplayName", "Bob <bob@example.com>", EmailOptions{}, CodeEmailDisplayName},
+		{"AngleAddr", "<bob@example.com>", EmailOptions{}, CodeEmailDisplayName},
+		{"AllowDisplayName", "Bob <bob@example.com>", EmailOptions{AllowDisplayName: true}, ""},
+		{"Blocked", "a@Mailinator.com", disposable, CodeEmailBlockedDomain},
+		{"BlockedSubdomain", "a@x.mailinator.com", disposable, CodeEmailBlockedDomain},
+		{"BlockedIDN", "a@例え.jp", disposable, CodeEmailBlockedDomain},
+		{"NotBlocked", "a@notmailinator.com", disposable, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidateEmailAddress(tt.value, "Email", tt.opts, "")
+
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Code != tt.wantCode {
+				t.Fatalf("Expected code: %v, got: %v", tt.wantCode, errs)
+			}
+		})
+	}
+}
+
+func TestValidateEmailAddressResolver(t *testing.T) {
+	resolver := stubMXResolver{
+		"example.com":      {{Host: "mx.example.com.", Pref: 10}},
+		"xn--bcher-kva.de": {{Host: "mx.xn--bcher-kva.de.", Pref: 10}},
+		"nullmx.example":   {{Host: ".", Pref: 0}},
+	}
+	tests := []struct {
+		name     string
+		value    string
+		wantCode string
+	}{
+		{"HasMX", "a@example.com", ""},
+		{"IDN", "a@bücher.de", ""},
+		{"NoMX", "a@nomail.example", CodeEmailDomain},
+		{"NullMX", "a@nullmx.example", CodeEmailDomain},
+		{"LookupError", "a@timeout.example", CodeAsync},
+		{"SyntaxError", "invalid", CodeEmail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidateEmailAddress(tt.value, "Email", EmailOptions{Resolver: resolver}, "")
+			if err := vc.Wait(context.Background()); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Code != tt.wantCode {
+				t.Fatalf("Expected code: %v, got: %v", tt.wantCode, errs)
+			}
+		})
+	}
+
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.ValidateEmailAddress("a@nomail.example", "Email", EmailOptions{Resolver: resolver}, "")
+	vc.Wait(context.Background())
+	errs := vc.Errors()
+	if len(errs) != 1 || !errors.Is(&errs[0], ErrEmailDomain) {
+		t.Fatalf("Expected ErrEmailDomain, got: %v", errs)
+	}
+	if errs[0].Message != "The domain of Email (nomail.example) cannot receive email." {
+		t.Errorf("Unexpected message: %v", errs[0].Message)
+	}
+}
+
+func TestValidateEmailAddressContext(t *testing.T) {
+	resolver := stubMXResolver{}
+
+	vc := NewValidationContext()
+	vc.ValidateEmailAddress("a@nomail.example", "Email", EmailOptions{Resolver: resolver}, "")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeEmailDomain {
+		t.Fatalf("Expected the lookup to run without Wait, got: %v", errs)
+	}
+
+	vc = NewValidationContext()
+	vc.ValidateEmailAddress("a@nomail.example", "Email", EmailOptions{Resolver: resolver, Context: context.Background()}, "")
+	if vc.HasErrors() {
+		t.Fatalf("Expected the lookup to be deferred to Wait, got: %v", vc.Errors())
+	}
+	if err := vc.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeEmailDomain {
+		t.Fatalf("Expected code: %v, got: %v", CodeEmailDomain, errs)
+	}
+}
+
+func TestDomainToASCII(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{"example.com", "example.com"},
+		{"Example.COM", "example.com"},
+		{"bücher.de", "xn--bcher-kva.de"},
+		{"BÜCHER.de", "xn--bcher-kva.de"},
+		{"bu\u0308cher.de", "xn--bcher-kva.de"},
+		{"münchen.de", "xn--mnchen-3ya.de"},
+		{"例え.jp", "xn--r8jz45g.jp"},
+		{"他们为什么不说中文", "xn--ihqwcrb4cv8a8dqg056pqjye"},
+		{"日本語ドメイン.jp", "xn--eckwd4c7c5976acvb2w6i.jp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			got, err := domainToASCII(tt.domain)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected: %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDomainToASCIIInvalid(t *testing.T) {
+	for _, domain := range []string{
+		"\u202eevil.com",    // right-to-left override
+		"evil\u200f.com",    // right-to-left mark
+		"ex\u200bample.com", // zero-width space
+		"ex\u00adample.com", // soft hyphen
+		"x\u200dy.com",      // zero-width joiner
+		"\uff45xample.com",  // full-width letter
+		"\u2603.com",        // snowman
+		"i\u2764.ws",        // heart
+		"ex\u2044ample.com", // fraction slash
+		"\u05d0\u0061.com",  // mixed bidi label
+	} {
+		if got, err := domainToASCII(domain); err == nil {
+			t.Errorf("domainToASCII(%q): expected an error, got %v", domain, got)
+		}
+	}
+}
@@ -1,52 +1,63 @@
 package validationcontext
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
 )
 
 // ValidateFilePath checks if the value is a valid file path.
+// A missing file is reported with CodeFilePath, a permission error with
+// CodePathPermission and any other stat error with CodeFileStat.
+// ValidatePath provides stricter checks.
 func (vc *ValidationContext) ValidateFilePath(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := os.Stat(value); err != nil {
-		if os.IsNotExist(err) {
-			if errMsg != "" {
-				vc.AddError(field, errMsg)
-				return
-			}
-			vc.AddError(field, fmt.Sprintf("%sには、有効なファイルパスを指定してください。", field))
-		}
+		vc.addStatError(field, err, CodeFilePath, errMsg)
 	}
 }
 
 // ValidateFileExtension checks if the file has a valid extension.
 func (vc *ValidationContext) ValidateFileExtension(file *os.File, field string, validExtensions []string, errMsg string) {
-	ext := filepath.Ext(file.Name())
-	for _, validExt := range validExtensions {
-		if ext == validExt {
-			return
-		}
-	}
-	if errMsg != "" {
-		vc.AddError(field, errMsg)
+	if vc.ShouldSkip(field) {
 		return
 	}
-	vc.AddError(field, fmt.Sprintf("%sには、有効な拡張子（%v）を持つファイルを指定してください。", field, validExtensions))
+	vc.validateExtension(field, file.Name(), validExtensions, errMsg)
 }
 
 // ValidateFileSize checks if the file size is within the specified limit.
 func (vc *ValidationContext) ValidateFileSize(file *os.File, field string, maxSize int64, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	fileInfo, err := file.Stat()
 	if err != nil {
-		vc.AddError(field, fmt.Sprintf("%sのファイル情報の取得に失敗しました: %v", field, err))
+		vc.AddErrorCode(field, CodeFileStat, "", map[string]interface{}{"error": err.Error()})
 		return
 	}
 
-	if fileInfo.Size() > maxSize {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-		} else {
-			vc.AddError(field, fmt.Sprintf("%sのファイルサイズは%dMB以下でなければなりません", field, maxSize/(1024*1024)))
+	vc.validateSize(field, fileInfo.Size(), maxSize, errMsg)
+}
+
+// validateExtension checks the extension of a file name.
+func (vc *ValidationContext) validateExtension(field, name string, validExtensions []string, errMsg string) {
+	ext := filepath.Ext(name)
+	for _, validExt := range validExtensions {
+		if ext == validExt {
+			return
 		}
 	}
+	vc.AddErrorCode(field, CodeFileExtension, errMsg, map[string]interface{}{"extensions": validExtensions})
+}
+
+// validateSize checks a file size against maxSize.
+func (vc *ValidationContext) validateSize(field string, size, maxSize int64, errMsg string) {
+	if size > maxSize {
+		vc.AddErrorCode(field, CodeFileSize, errMsg, map[string]interface{}{
+			"max":    maxSize,
+			"max_mb": maxSize / (1024 * 1024),
+			"size":   size,
+		})
+	}
 }
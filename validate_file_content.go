@@ -0,0 +1,186 @@
+package validationcontext
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sniffLen is the number of bytes read to detect the content type of a file.
+const sniffLen = 512
+
+// contentSignatures are the magic numbers recognized by DetectContentType,
+// in the order they are tried.
+var contentSignatures = []struct {
+	contentType string
+	offset      int
+	magic       []byte
+}{
+	{"image/png", 0, []byte("\x89PNG\r\n\x1a\n")},
+	{"image/jpeg", 0, []byte("\xff\xd8\xff")},
+	{"image/gif", 0, []byte("GIF87a")},
+	{"image/gif", 0, []byte("GIF89a")},
+	{"image/bmp", 0, []byte("BM")},
+	{"image/tiff", 0, []byte("II*\x00")},
+	{"image/tiff", 0, []byte("MM\x00*")},
+	{"application/pdf", 0, []byte("%PDF-")},
+	{"application/zip", 0, []byte("PK\x03\x04")},
+	{"application/zip", 0, []byte("PK\x05\x06")},
+	{"application/gzip", 0, []byte("\x1f\x8b\x08")},
+	{"application/x-tar", 257, []byte("ustar")},
+	{"application/x-7z-compressed", 0, []byte("7z\xbc\xaf\x27\x1c")},
+	{"application/vnd.rar", 0, []byte("Rar!\x1a\x07")},
+	{"application/x-msdownload", 0, []byte("MZ")},
+	{"application/x-executable", 0, []byte("\x7fELF")},
+}
+
+// ftypBrands maps the major brands of ISO base media files to content types.
+var ftypBrands = map[string]string{
+	"heic": "image/heic",
+	"heix": "image/heic",
+	"hevc": "image/heic",
+	"hevx": "image/heic",
+	"heim": "image/heic",
+	"heis": "image/heic",
+	"mif1": "image/heif",
+	"msf1": "image/heif",
+	"avif": "image/avif",
+	"avis": "image/avif",
+	"isom": "video/mp4",
+	"mp41": "video/mp4",
+	"mp42": "video/mp4",
+	"qt  ": "video/quicktime",
+}
+
+// contentExtensions lists the extensions that agree with a content type.
+var contentExtensions = map[string][]string{
+	"image/png":                   {".png"},
+	"image/jpeg":                  {".jpg", ".jpeg", ".jpe", ".jfif"},
+	"image/gif":                   {".gif"},
+	"image/webp":                  {".webp"},
+	"image/bmp":                   {".bmp"},
+	"image/tiff":                  {".tif", ".tiff"},
+	"image/heic":                  {".heic", ".heif"},
+	"image/heif":                  {".heif", ".heic"},
+	"image/avif":                  {".avif"},
+	"application/pdf":             {".pdf"},
+	"application/zip":             {".zip", ".docx", ".xlsx", ".pptx", ".odt", ".ods", ".odp", ".jar", ".apk", ".epub"},
+	"application/gzip":            {".gz", ".tgz"},
+	"application/x-tar":           {".tar"},
+	"application/x-7z-compressed": {".7z"},
+	"application/vnd.rar":         {".rar"},
+	"video/mp4":                   {".mp4", ".m4v", ".m4a"},
+	"video/quicktime":             {".mov"},
+}
+
+// DetectContentType returns the MIME type of data from its magic numbers, e.g.
+// "image/png" or "application/pdf". It considers at most the first 512 bytes.
+// Content without a known signature is classified by http.DetectContentType,
+// without parameters, e.g. "text/plain" or "application/octet-stream".
+func DetectContentType(data []byte) string {
+	if len(data) > sniffLen {
+		data = data[:sniffLen]
+	}
+	for _, sig := range contentSignatures {
+		if len(data) >= sig.offset+len(sig.magic) && bytes.Equal(data[sig.offset:sig.offset+len(sig.magic)], sig.magic) {
+			return sig.contentType
+		}
+	}
+	if len(data) >= 12 && bytes.Equal(data[:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")) {
+		return "image/webp"
+	}
+	if len(data) >= 12 && bytes.Equal(data[4:8], []byte("ftyp")) {
+		if contentType, ok := ftypBrands[string(data[8:12])]; ok {
+			return contentType
+		}
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return contentType
+}
+
+// ValidateFileContentType checks the actual content of the file against the
+// allowed MIME types, e.g. "image/png" or "image/*", and that the extension of
+// the file name agrees with the content, so that a renamed executable is not
+// accepted as an image. The content type is detected with DetectContentType
+// and the file offset is restored afterwards.
+func (vc *ValidationContext) ValidateFileContentType(file *os.File, field string, allowedTypes []string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	head, err := sniffReadSeeker(file)
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	vc.validateContentType(field, file.Name(), head, allowedTypes, errMsg)
+}
+
+// validateContentType checks the content type detected from head against
+// allowedTypes and the extension of name.
+func (vc *ValidationContext) validateContentType(field, name string, head []byte, allowedTypes []string, errMsg string) {
+	contentType := DetectContentType(head)
+	if !contentTypeAllowed(contentType, allowedTypes) {
+		vc.AddErrorCode(field, CodeFileContentType, errMsg, map[string]interface{}{
+			"content_type": contentType,
+			"allowed":      allowedTypes,
+		})
+		return
+	}
+
+	extensions, ok := contentExtensions[contentType]
+	if !ok {
+		return
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, validExt := range extensions {
+		if ext == validExt {
+			return
+		}
+	}
+	vc.AddErrorCode(field, CodeFileExtensionMismatch, errMsg, map[string]interface{}{
+		"extension":    ext,
+		"content_type": contentType,
+	})
+}
+
+func contentTypeAllowed(contentType string, allowedTypes []string) bool {
+	for _, allowed := range allowedTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == contentType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(contentType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// sniffReadSeeker reads the first bytes of rs and restores its offset.
+func sniffReadSeeker(rs io.ReadSeeker) (head []byte, err error) {
+	offset, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if _, seekErr := rs.Seek(offset, io.SeekStart); seekErr != nil && err == nil {
+			err = seekErr
+		}
+	}()
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	head = make([]byte, sniffLen)
+	n, err := io.ReadFull(rs, head)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return head[:n], err
+}
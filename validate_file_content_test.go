@@ -0,0 +1,110 @@
+package validationcontext
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+var (
+	testPNG  = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	testJPEG = []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+	testEXE  = []byte("MZ\x90\x00\x03\x00\x00\x00\x04\x00\x00\x00\xff\xff")
+)
+
+// createTempContentFile creates a temporary file with the given name pattern and content.
+func createTempContentFile(t *testing.T, pattern string, content []byte) *os.File {
+	t.Helper()
+	tmpFile, err := os.CreateTemp("", pattern)
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	t.Cleanup(func() {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+	})
+	if _, err := tmpFile.Write(content); err != nil {
+		t.Fatalf("Failed to write to temporary file: %v", err)
+	}
+	return tmpFile
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"PNG", testPNG, "image/png"},
+		{"JPEG", testJPEG, "image/jpeg"},
+		{"GIF", []byte("GIF89a\x01\x00\x01\x00"), "image/gif"},
+		{"WebP", []byte("RIFF\x24\x00\x00\x00WEBPVP8 "), "image/webp"},
+		{"PDF", []byte("%PDF-1.7\n"), "application/pdf"},
+		{"ZIP", []byte("PK\x03\x04\x14\x00\x00\x00"), "application/zip"},
+		{"HEIC", []byte("\x00\x00\x00\x18ftypheic\x00\x00\x00\x00"), "image/heic"},
+		{"HEIF", []byte("\x00\x00\x00\x18ftypmif1\x00\x00\x00\x00"), "image/heif"},
+		{"EXE", testEXE, "application/x-msdownload"},
+		{"Text", []byte("hello, world"), "text/plain"},
+		{"Empty", nil, "text/plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectContentType(tt.data); got != tt.want {
+				t.Errorf("Expected: %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateFileContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		content  []byte
+		allowed  []string
+		wantCode string
+	}{
+		{"Valid", "license*.png", testPNG, []string{"image/png", "image/jpeg"}, ""},
+		{"Wildcard", "license*.JPG", testJPEG, []string{"image/*"}, ""},
+		{"RenamedExecutable", "license*.png", testEXE, []string{"image/png"}, CodeFileContentType},
+		{"ExtensionMismatch", "license*.png", testJPEG, []string{"image/png", "image/jpeg"}, CodeFileExtensionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := createTempContentFile(t, tt.pattern, tt.content)
+			if _, err := file.Seek(3, io.SeekStart); err != nil {
+				t.Fatalf("Failed to seek: %v", err)
+			}
+
+			vc := NewValidationContext()
+			vc.ValidateFileContentType(file, "LicenseImage", tt.allowed, "")
+
+			if offset, _ := file.Seek(0, io.SeekCurrent); offset != 3 {
+				t.Errorf("Expected the file offset to be restored, got: %v", offset)
+			}
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Code != tt.wantCode {
+				t.Fatalf("Expected code: %v, got: %v", tt.wantCode, errs)
+			}
+		})
+	}
+}
+
+func TestValidateFileContentTypeClosedFile(t *testing.T) {
+	file := createTempContentFile(t, "license*.png", testPNG)
+	file.Close()
+
+	vc := NewValidationContext()
+	vc.FileField("LicenseImage", file).ContentType("image/png")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeFileRead {
+		t.Errorf("Expected a read error, got: %v", errs)
+	}
+}
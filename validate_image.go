@@ -0,0 +1,127 @@
+package validationcontext
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"  // register the GIF decoder
+	_ "image/jpeg" // register the JPEG decoder
+	_ "image/png"  // register the PNG decoder
+	"io"
+	"math"
+	"os"
+)
+
+// DefaultMaxImagePixels is the pixel count ValidateImage accepts when
+// ImageConstraints.MaxPixels is zero, about 8000x5000.
+const DefaultMaxImagePixels = 40_000_000
+
+// ImageConstraints describes the images accepted by ValidateImage.
+// A zero width, height or aspect ratio is not checked.
+type ImageConstraints struct {
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+	// MaxPixels caps width*height. It is checked from the image header before
+	// the image is decoded, to protect against decompression bombs. Zero means
+	// DefaultMaxImagePixels, and a negative value removes the cap.
+	MaxPixels int64
+	// AspectRatio is the expected width/height ratio, e.g. 16.0/9.
+	AspectRatio float64
+	// AspectRatioTolerance is the accepted relative deviation from AspectRatio,
+	// e.g. 0.05 for 5%.
+	AspectRatioTolerance float64
+}
+
+// ValidateImage checks that the file is a PNG, JPEG or GIF image that decodes
+// and satisfies the constraints. The dimensions are read with image.DecodeConfig
+// and checked before the whole image is decoded. The file offset is restored
+// afterwards.
+func (vc *ValidationContext) ValidateImage(file *os.File, field string, constraints ImageConstraints, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	vc.validateImage(file, field, constraints, errMsg)
+}
+
+// validateImage applies ValidateImage to rs from its start and restores its offset.
+func (vc *ValidationContext) validateImage(rs io.ReadSeeker, field string, c ImageConstraints, errMsg string) {
+	offset, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	defer rs.Seek(offset, io.SeekStart)
+
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	config, format, err := image.DecodeConfig(rs)
+	if err != nil {
+		vc.AddErrorCode(field, CodeImageDecode, errMsg, map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if !vc.validateImageConfig(field, config, c, errMsg) {
+		return
+	}
+
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if _, _, err := image.Decode(rs); err != nil {
+		vc.AddErrorCode(field, CodeImageDecode, errMsg, map[string]interface{}{
+			"error":  fmt.Sprintf("%s: %v", format, err),
+			"format": format,
+		})
+	}
+}
+
+// validateImageConfig checks the dimensions of an image and reports whether they are valid.
+func (vc *ValidationContext) validateImageConfig(field string, config image.Config, c ImageConstraints, errMsg string) bool {
+	width, height := config.Width, config.Height
+	maxPixels := c.MaxPixels
+	if maxPixels == 0 {
+		maxPixels = DefaultMaxImagePixels
+	}
+	if pixels := int64(width) * int64(height); maxPixels > 0 && pixels > maxPixels {
+		vc.AddErrorCode(field, CodeImagePixels, errMsg, map[string]interface{}{
+			"pixels":     pixels,
+			"max_pixels": maxPixels,
+		})
+		return false
+	}
+
+	valid := true
+	if (c.MinWidth > 0 && width < c.MinWidth) || (c.MinHeight > 0 && height < c.MinHeight) {
+		vc.AddErrorCode(field, CodeImageTooSmall, errMsg, map[string]interface{}{
+			"width":      width,
+			"height":     height,
+			"min_width":  c.MinWidth,
+			"min_height": c.MinHeight,
+		})
+		valid = false
+	}
+	if (c.MaxWidth > 0 && width > c.MaxWidth) || (c.MaxHeight > 0 && height > c.MaxHeight) {
+		vc.AddErrorCode(field, CodeImageTooLarge, errMsg, map[string]interface{}{
+			"width":      width,
+			"height":     height,
+			"max_width":  c.MaxWidth,
+			"max_height": c.MaxHeight,
+		})
+		valid = false
+	}
+	if c.AspectRatio > 0 && height > 0 {
+		ratio := float64(width) / float64(height)
+		if math.Abs(ratio-c.AspectRatio)/c.AspectRatio > c.AspectRatioTolerance+1e-9 {
+			vc.AddErrorCode(field, CodeImageAspectRatio, errMsg, map[string]interface{}{
+				"ratio":     math.Round(ratio*100) / 100,
+				"expected":  math.Round(c.AspectRatio*100) / 100,
+				"tolerance": c.AspectRatioTolerance,
+			})
+			valid = false
+		}
+	}
+	return valid
+}
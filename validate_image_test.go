@@ -0,0 +1,101 @@
+package validationcontext
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+)
+
+func encodeTestImage(t *testing.T, width, height int, encode func(w io.Writer, m image.Image) error) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("Failed to encode image: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// pngHeader returns the signature and IHDR chunk of a PNG image of the given
+// dimensions, without any image data.
+func pngHeader(width, height uint32) []byte {
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], width)
+	binary.BigEndian.PutUint32(ihdr[8:], height)
+	ihdr[12], ihdr[13] = 8, 6 // 8-bit RGBA
+	var buf bytes.Buffer
+	buf.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&buf, binary.BigEndian, uint32(len(ihdr)-4))
+	buf.Write(ihdr)
+	binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(ihdr))
+	return buf.Bytes()
+}
+
+func TestValidateImage(t *testing.T) {
+	encodeJPEG := func(w io.Writer, m image.Image) error { return jpeg.Encode(w, m, nil) }
+	landscape := encodeTestImage(t, 160, 90, png.Encode)
+
+	tests := []struct {
+		name        string
+		content     []byte
+		constraints ImageConstraints
+		wantCodes   []string
+	}{
+		{"Valid", landscape, ImageConstraints{MinWidth: 100, MaxWidth: 200, MaxPixels: 20000, AspectRatio: 16.0 / 9}, nil},
+		{"JPEG", encodeTestImage(t, 90, 160, encodeJPEG), ImageConstraints{AspectRatio: 9.0 / 16}, nil},
+		{"TooSmall", landscape, ImageConstraints{MinWidth: 200, MinHeight: 50}, []string{CodeImageTooSmall}},
+		{"TooLarge", landscape, ImageConstraints{MaxHeight: 50}, []string{CodeImageTooLarge}},
+		{"TooManyPixels", landscape, ImageConstraints{MaxPixels: 10000, MinWidth: 1000}, []string{CodeImagePixels}},
+		{"DefaultMaxPixels", pngHeader(10000, 5000), ImageConstraints{}, []string{CodeImagePixels}},
+		{"NoMaxPixels", pngHeader(10000, 5000), ImageConstraints{MaxPixels: -1}, []string{CodeImageDecode}},
+		{"AspectRatio", landscape, ImageConstraints{AspectRatio: 4.0 / 3}, []string{CodeImageAspectRatio}},
+		{"AspectRatioTolerance", landscape, ImageConstraints{AspectRatio: 1.7, AspectRatioTolerance: 0.05}, nil},
+		{"NotAnImage", []byte("not an image"), ImageConstraints{}, []string{CodeImageDecode}},
+		{"Truncated", landscape[:len(landscape)-20], ImageConstraints{}, []string{CodeImageDecode}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := createTempContentFile(t, "license*.png", tt.content)
+
+			vc := NewValidationContext()
+			vc.ValidateImage(file, "LicenseImage", tt.constraints, "")
+
+			if offset, _ := file.Seek(0, io.SeekCurrent); offset != int64(len(tt.content)) {
+				t.Errorf("Expected the file offset to be restored, got: %v", offset)
+			}
+			errs := vc.Errors()
+			if len(errs) != len(tt.wantCodes) {
+				t.Fatalf("Expected codes: %v, got: %v", tt.wantCodes, errs)
+			}
+			for i, code := range tt.wantCodes {
+				if errs[i].Code != code {
+					t.Errorf("Expected code: %v, got: %v", code, errs[i].Code)
+				}
+			}
+		})
+	}
+}
+
+func TestImageRules(t *testing.T) {
+	file := createTempContentFile(t, "license*.png", encodeTestImage(t, 160, 90, png.Encode))
+
+	vc := NewValidationContext(WithLocale(LocaleEn))
+	vc.FileField("LicenseImage", file).ContentType("image/png").Image(ImageConstraints{MinWidth: 320, MinHeight: 180})
+
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Code != CodeImageTooSmall {
+		t.Fatalf("Expected a too small error, got: %v", errs)
+	}
+	if errs[0].Message != "LicenseImage (160x90) is too small." {
+		t.Errorf("Unexpected message: %v", errs[0].Message)
+	}
+	if errs[0].Params["min_width"] != 320 || errs[0].Params["width"] != 160 {
+		t.Errorf("Unexpected params: %v", errs[0].Params)
+	}
+}
@@ -1,25 +1,138 @@
 package validationcontext
 
 import (
-	"fmt"
+	"math"
+	"reflect"
 )
 
+// Integer is a constraint for all integer types, including named types such as time.Duration.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint for all floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint for all ordered numeric types.
+type Number interface {
+	Integer | Float
+}
+
 func (vc *ValidationContext) ValidateMinValue(value int, field string, minValue int, errMsg string) {
-	if value < minValue {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sは%d以上で入力してください。", field, minValue))
-	}
+	ValidateMin(vc, value, field, minValue, errMsg)
 }
 
 func (vc *ValidationContext) ValidateMaxValue(value int, field string, maxValue int, errMsg string) {
-	if value > maxValue {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sは%d以下で入力してください。", field, maxValue))
+	ValidateMax(vc, value, field, maxValue, errMsg)
+}
+
+// ValidateMin checks if the value is greater than or equal to min.
+// NaN never satisfies the rule.
+func ValidateMin[T Number](vc *ValidationContext, value T, field string, min T, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value >= min) {
+		vc.AddErrorCode(field, CodeMinValue, errMsg, map[string]interface{}{"min": min})
+	}
+}
+
+// ValidateMax checks if the value is less than or equal to max.
+// NaN never satisfies the rule.
+func ValidateMax[T Number](vc *ValidationContext, value T, field string, max T, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value <= max) {
+		vc.AddErrorCode(field, CodeMaxValue, errMsg, map[string]interface{}{"max": max})
+	}
+}
+
+// ValidateBetween checks if the value is within [min, max], bounds included.
+func ValidateBetween[T Number](vc *ValidationContext, value T, field string, min, max T, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value >= min && value <= max) {
+		vc.AddErrorCode(field, CodeBetween, errMsg, map[string]interface{}{"min": min, "max": max})
+	}
+}
+
+// ValidateBetweenExclusive checks if the value is within (min, max), bounds excluded.
+func ValidateBetweenExclusive[T Number](vc *ValidationContext, value T, field string, min, max T, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value > min && value < max) {
+		vc.AddErrorCode(field, CodeBetweenExclusive, errMsg, map[string]interface{}{"min": min, "max": max})
+	}
+}
+
+// ValidatePositive checks if the value is greater than zero.
+func ValidatePositive[T Number](vc *ValidationContext, value T, field string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value > 0) {
+		vc.AddErrorCode(field, CodePositive, errMsg, nil)
+	}
+}
+
+// ValidateNegative checks if the value is less than zero.
+func ValidateNegative[T Number](vc *ValidationContext, value T, field string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if !(value < 0) {
+		vc.AddErrorCode(field, CodeNegative, errMsg, nil)
+	}
+}
+
+// ValidateNonZero checks if the value is not zero.
+func ValidateNonZero[T Number](vc *ValidationContext, value T, field string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if value == 0 {
+		vc.AddErrorCode(field, CodeNonZero, errMsg, nil)
+	}
+}
+
+// ValidateMultipleOf checks if the value is a multiple of step, e.g. a price in steps of 0.05.
+// Floating-point values are compared with a small relative tolerance. A zero step disables the rule.
+func ValidateMultipleOf[T Number](vc *ValidationContext, value T, field string, step T, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	if step == 0 || isMultipleOf(value, step) {
+		return
+	}
+	vc.AddErrorCode(field, CodeMultipleOf, errMsg, map[string]interface{}{"step": step})
+}
+
+// ValidateFinite checks if the value is neither NaN nor infinite.
+func ValidateFinite[T Float](vc *ValidationContext, value T, field string, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	f := float64(value)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		vc.AddErrorCode(field, CodeFinite, errMsg, nil)
+	}
+}
+
+func isMultipleOf[T Number](value, step T) bool {
+	v, s := reflect.ValueOf(value), reflect.ValueOf(step)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		q := v.Float() / s.Float()
+		return math.Abs(q-math.Round(q)) <= 1e-9*math.Max(1, math.Abs(q))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()%s.Uint() == 0
+	default:
+		return v.Int()%s.Int() == 0
 	}
 }
@@ -0,0 +1,89 @@
+package validationcontext
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGenericNumericValidators(t *testing.T) {
+	tests := []struct {
+		name           string
+		validate       func(vc *ValidationContext)
+		wantCode       string
+		expectErrCount int
+	}{
+		{"MinInt64Below", func(vc *ValidationContext) { ValidateMin(vc, int64(1)<<40, "ID", int64(1)<<41, "") }, CodeMinValue, 1},
+		{"MinInt64Met", func(vc *ValidationContext) { ValidateMin(vc, int64(1)<<41, "ID", int64(1)<<41, "") }, "", 0},
+		{"MinFloatNaN", func(vc *ValidationContext) { ValidateMin(vc, math.NaN(), "Price", 0, "") }, CodeMinValue, 1},
+		{"MaxUint32Above", func(vc *ValidationContext) { ValidateMax(vc, uint32(11), "Counter", 10, "") }, CodeMaxValue, 1},
+		{"MaxDurationMet", func(vc *ValidationContext) { ValidateMax(vc, time.Second, "Timeout", time.Minute, "") }, "", 0},
+		{"BetweenLowerBound", func(vc *ValidationContext) { ValidateBetween(vc, 1.5, "Price", 1.5, 2.5, "") }, "", 0},
+		{"BetweenAbove", func(vc *ValidationContext) { ValidateBetween(vc, 2.6, "Price", 1.5, 2.5, "") }, CodeBetween, 1},
+		{"BetweenExclusiveBound", func(vc *ValidationContext) { ValidateBetweenExclusive(vc, 10, "Age", 10, 20, "") }, CodeBetweenExclusive, 1},
+		{"BetweenExclusiveInside", func(vc *ValidationContext) { ValidateBetweenExclusive(vc, 11, "Age", 10, 20, "") }, "", 0},
+		{"PositiveZero", func(vc *ValidationContext) { ValidatePositive(vc, 0, "Amount", "") }, CodePositive, 1},
+		{"PositiveMet", func(vc *ValidationContext) { ValidatePositive(vc, 0.01, "Amount", "") }, "", 0},
+		{"NegativePositive", func(vc *ValidationContext) { ValidateNegative(vc, int8(1), "Offset", "") }, CodeNegative, 1},
+		{"NonZeroZero", func(vc *ValidationContext) { ValidateNonZero(vc, uint64(0), "Count", "") }, CodeNonZero, 1},
+		{"MultipleOfInt", func(vc *ValidationContext) { ValidateMultipleOf(vc, 15, "Minutes", 5, "") }, "", 0},
+		{"NotMultipleOfInt", func(vc *ValidationContext) { ValidateMultipleOf(vc, 16, "Minutes", 5, "") }, CodeMultipleOf, 1},
+		{"MultipleOfFloat", func(vc *ValidationContext) { ValidateMultipleOf(vc, 0.3, "Price", 0.1, "") }, "", 0},
+		{"NotMultipleOfFloat", func(vc *ValidationContext) { ValidateMultipleOf(vc, 0.35, "Price", 0.1, "") }, CodeMultipleOf, 1},
+		{"MultipleOfDuration", func(vc *ValidationContext) { ValidateMultipleOf(vc, 90*time.Second, "Interval", time.Minute, "") }, CodeMultipleOf, 1},
+		{"FiniteInf", func(vc *ValidationContext) { ValidateFinite(vc, math.Inf(1), "Price", "") }, CodeFinite, 1},
+		{"FiniteNaN", func(vc *ValidationContext) { ValidateFinite(vc, float32(math.NaN()), "Price", "") }, CodeFinite, 1},
+		{"FiniteMet", func(vc *ValidationContext) { ValidateFinite(vc, 1.5, "Price", "") }, "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			tt.validate(vc)
+			if len(vc.Errors()) != tt.expectErrCount {
+				t.Fatalf("Expected error count: %v, got: %v", tt.expectErrCount, len(vc.Errors()))
+			}
+			if tt.expectErrCount > 0 && vc.Errors()[0].Code != tt.wantCode {
+				t.Errorf("Expected code: %v, got: %v", tt.wantCode, vc.Errors()[0].Code)
+			}
+		})
+	}
+}
+
+func TestGenericNumericMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		validate func(vc *ValidationContext)
+		want     string
+	}{
+		{"BetweenJa", LocaleJa, func(vc *ValidationContext) { ValidateBetween(vc, 0.5, "価格", 1.5, 2.5, "") }, "価格は1.5以上2.5以下で入力してください。"},
+		{"BetweenEn", LocaleEn, func(vc *ValidationContext) { ValidateBetween(vc, 0.5, "Price", 1.5, 2.5, "") }, "Price must be between 1.5 and 2.5."},
+		{"MaxDurationEn", LocaleEn, func(vc *ValidationContext) { ValidateMax(vc, time.Hour, "Timeout", time.Minute, "") }, "Timeout must be 1m0s or less."},
+		{"MultipleOfJa", LocaleJa, func(vc *ValidationContext) { ValidateMultipleOf(vc, 7, "分", 5, "") }, "分は5の倍数で入力してください。"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext(WithLocale(tt.locale))
+			tt.validate(vc)
+			if got := vc.Errors()[0].Message; got != tt.want {
+				t.Errorf("Expected message: %v, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateStructNumericKinds(t *testing.T) {
+	type product struct {
+		Price float64 `validate:"min=0.5,max=100"`
+		Stock uint32  `validate:"max=10"`
+		ID    int64   `validate:"min=1"`
+	}
+
+	vc := NewValidationContext()
+	vc.ValidateStruct(product{Price: 0.1, Stock: 11, ID: 0})
+	if len(vc.Errors()) != 3 {
+		t.Errorf("Expected error count: 3, got: %v", len(vc.Errors()))
+	}
+}
@@ -0,0 +1,159 @@
+package validationcontext
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PathConstraints describes the paths accepted by ValidatePath.
+// The zero value accepts any existing path.
+type PathConstraints struct {
+	// BaseDir is the directory the path must stay within, after symbolic links
+	// are resolved. A relative path is interpreted relative to BaseDir.
+	BaseDir string
+	// AllowMissing accepts a path that does not exist, e.g. a file to be
+	// created. Only its containment in BaseDir is checked then.
+	AllowMissing bool
+	// RegularFile requires a regular file.
+	RegularFile bool
+	// Directory requires a directory.
+	Directory bool
+	// Readable and Writable require the path to be opened for reading or
+	// writing by the current process. A directory is writable if a file can be
+	// created in it. Other special files, such as FIFOs and devices, are
+	// neither, since opening them may block or have side effects.
+	Readable bool
+	Writable bool
+}
+
+// ValidatePath checks a file system path supplied by a user, e.g.
+//
+//	vc.ValidatePath(name, "Report", validationcontext.PathConstraints{BaseDir: "/srv/reports", RegularFile: true, Readable: true}, "")
+//
+// Each failure has its own code: CodePathNotExist, CodePathPermission,
+// CodePathOutsideBase, CodePathIsDirectory, CodePathNotRegular,
+// CodePathNotDirectory, CodePathNotReadable and CodePathNotWritable. Other
+// stat errors are reported with CodeFileStat.
+func (vc *ValidationContext) ValidatePath(value, field string, c PathConstraints, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
+	p := value
+	if c.BaseDir != "" && !filepath.IsAbs(p) {
+		p = filepath.Join(c.BaseDir, p)
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && c.AllowMissing:
+			if c.BaseDir != "" {
+				vc.validateContainment(field, p, c.BaseDir, errMsg)
+			}
+		default:
+			vc.addStatError(field, err, CodePathNotExist, errMsg)
+		}
+		return
+	}
+
+	if c.BaseDir != "" && !vc.validateContainment(field, p, c.BaseDir, errMsg) {
+		return
+	}
+	switch {
+	case c.RegularFile && info.IsDir():
+		vc.AddErrorCode(field, CodePathIsDirectory, errMsg, nil)
+		return
+	case c.RegularFile && !info.Mode().IsRegular():
+		vc.AddErrorCode(field, CodePathNotRegular, errMsg, nil)
+		return
+	case c.Directory && !info.IsDir():
+		vc.AddErrorCode(field, CodePathNotDirectory, errMsg, nil)
+		return
+	}
+	if c.Readable && !isReadable(p, info.Mode()) {
+		vc.AddErrorCode(field, CodePathNotReadable, errMsg, nil)
+	}
+	if c.Writable && !isWritable(p, info.Mode()) {
+		vc.AddErrorCode(field, CodePathNotWritable, errMsg, nil)
+	}
+}
+
+// addStatError reports a stat error, with notExistCode if the path does not exist.
+func (vc *ValidationContext) addStatError(field string, err error, notExistCode, errMsg string) {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		vc.AddErrorCode(field, notExistCode, errMsg, nil)
+	case errors.Is(err, fs.ErrPermission):
+		vc.AddErrorCode(field, CodePathPermission, errMsg, nil)
+	default:
+		vc.AddErrorCode(field, CodeFileStat, "", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+// validateContainment checks that p resolves to a location within baseDir and
+// reports whether it does. The parent directory is resolved for a missing path.
+func (vc *ValidationContext) validateContainment(field, p, baseDir, errMsg string) bool {
+	base, err := filepath.EvalSymlinks(baseDir)
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileStat, "", map[string]interface{}{"error": err.Error()})
+		return false
+	}
+	resolved, err := filepath.EvalSymlinks(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		var dir string
+		dir, err = filepath.EvalSymlinks(filepath.Dir(p))
+		resolved = filepath.Join(dir, filepath.Base(p))
+	}
+	if err != nil {
+		vc.addStatError(field, err, CodePathNotExist, errMsg)
+		return false
+	}
+
+	rel, err := filepath.Rel(base, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		vc.AddErrorCode(field, CodePathOutsideBase, errMsg, map[string]interface{}{"base": baseDir})
+		return false
+	}
+	return true
+}
+
+// isOpenable reports whether a file of the given mode can be opened safely.
+func isOpenable(mode fs.FileMode) bool {
+	return mode.IsRegular() || mode.IsDir()
+}
+
+func isReadable(p string, mode fs.FileMode) bool {
+	if !isOpenable(mode) {
+		return false
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
+
+func isWritable(p string, mode fs.FileMode) bool {
+	if !isOpenable(mode) {
+		return false
+	}
+	if mode.IsDir() {
+		f, err := os.CreateTemp(p, ".validationcontext-*")
+		if err != nil {
+			return false
+		}
+		f.Close()
+		os.Remove(f.Name())
+		return true
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY, 0)
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return true
+}
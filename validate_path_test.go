@@ -0,0 +1,117 @@
+package validationcontext
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidatePath(t *testing.T) {
+	root := t.TempDir()
+	base := filepath.Join(root, "base")
+	if err := os.MkdirAll(filepath.Join(base, "reports"), 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	report := filepath.Join(base, "reports", "2024.csv")
+	secret := filepath.Join(root, "secret.txt")
+	for _, name := range []string{report, secret} {
+		if err := os.WriteFile(name, []byte("data"), 0o644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+	if err := os.Symlink(secret, filepath.Join(base, "link.txt")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+	if err := os.Symlink(root, filepath.Join(base, "escape")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+
+	file := PathConstraints{BaseDir: base, RegularFile: true, Readable: true, Writable: true}
+	tests := []struct {
+		name        string
+		value       string
+		constraints PathConstraints
+		wantCode    string
+	}{
+		{"Valid", "reports/2024.csv", file, ""},
+		{"ValidAbsolute", report, file, ""},
+		{"NotExist", "reports/2025.csv", file, CodePathNotExist},
+		{"AllowMissing", "reports/2025.csv", PathConstraints{BaseDir: base, AllowMissing: true}, ""},
+		{"AllowMissingOutside", "../new.csv", PathConstraints{BaseDir: base, AllowMissing: true}, CodePathOutsideBase},
+		{"AllowMissingParent", "missing/new.csv", PathConstraints{BaseDir: base, AllowMissing: true}, CodePathNotExist},
+		{"Traversal", "../secret.txt", file, CodePathOutsideBase},
+		{"AbsoluteOutside", secret, file, CodePathOutsideBase},
+		{"SymlinkOutside", "link.txt", file, CodePathOutsideBase},
+		{"SymlinkDirOutside", "escape/secret.txt", file, CodePathOutsideBase},
+		{"IsDirectory", "reports", file, CodePathIsDirectory},
+		{"Directory", "reports", PathConstraints{BaseDir: base, Directory: true, Writable: true}, ""},
+		{"NotDirectory", "reports/2024.csv", PathConstraints{BaseDir: base, Directory: true}, CodePathNotDirectory},
+		{"NotRegular", os.DevNull, PathConstraints{RegularFile: true}, CodePathNotRegular},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidatePath(tt.value, "Report", tt.constraints, "")
+
+			errs := vc.Errors()
+			if tt.wantCode == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Code != tt.wantCode {
+				t.Fatalf("Expected code: %v, got: %v", tt.wantCode, errs)
+			}
+		})
+	}
+
+	entries, err := os.ReadDir(filepath.Join(base, "reports"))
+	if err != nil || len(entries) != 1 {
+		t.Errorf("Expected the writability check to leave no files behind, got: %v", entries)
+	}
+}
+
+func TestValidatePathPermissions(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("permission checks do not apply to root")
+	}
+	dir := t.TempDir()
+	readOnly := filepath.Join(dir, "readonly.txt")
+	writeOnly := filepath.Join(dir, "writeonly.txt")
+	os.WriteFile(readOnly, []byte("data"), 0o444)
+	os.WriteFile(writeOnly, []byte("data"), 0o222)
+	locked := filepath.Join(dir, "locked")
+	os.Mkdir(locked, 0o755)
+	os.WriteFile(filepath.Join(locked, "file.txt"), []byte("data"), 0o644)
+	os.Chmod(locked, 0)
+	t.Cleanup(func() { os.Chmod(locked, 0o755) })
+
+	tests := []struct {
+		name        string
+		value       string
+		constraints PathConstraints
+		wantCode    string
+	}{
+		{"NotWritable", readOnly, PathConstraints{Writable: true}, CodePathNotWritable},
+		{"NotReadable", writeOnly, PathConstraints{Readable: true}, CodePathNotReadable},
+		{"Permission", filepath.Join(locked, "file.txt"), PathConstraints{}, CodePathPermission},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidatePath(tt.value, "Report", tt.constraints, "")
+			if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != tt.wantCode {
+				t.Fatalf("Expected code: %v, got: %v", tt.wantCode, errs)
+			}
+		})
+	}
+
+	vc := NewValidationContext()
+	vc.ValidateFilePath(filepath.Join(locked, "file.txt"), "FilePath", "")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodePathPermission {
+		t.Errorf("Expected ValidateFilePath to report permission errors, got: %v", errs)
+	}
+}
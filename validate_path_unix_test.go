@@ -0,0 +1,34 @@
+//go:build unix
+
+package validationcontext
+
+import (
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestValidatePathFIFO(t *testing.T) {
+	base := t.TempDir()
+	fifo := filepath.Join(base, "pipe")
+	if err := syscall.Mkfifo(fifo, 0o666); err != nil {
+		t.Skipf("Failed to create a FIFO: %v", err)
+	}
+
+	done := make(chan []ValidationError, 1)
+	go func() {
+		vc := NewValidationContext()
+		vc.ValidatePath("pipe", "Report", PathConstraints{BaseDir: base, Readable: true, Writable: true}, "")
+		done <- vc.Errors()
+	}()
+
+	select {
+	case errs := <-done:
+		if len(errs) != 2 || errs[0].Code != CodePathNotReadable || errs[1].Code != CodePathNotWritable {
+			t.Errorf("Expected the FIFO to be neither readable nor writable, got: %v", errs)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ValidatePath blocked on a FIFO")
+	}
+}
@@ -1,22 +1,20 @@
 package validationcontext
 
 import (
-	"fmt"
 	"reflect"
 )
 
 // Required adds a required validation rule to the context.
 func (vc *ValidationContext) Required(value interface{}, field string, message string, skipNil bool) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	value, isNil := indirect(value)
 	if skipNil && isNil {
 		return
 	}
 	if isNil || isEmpty(value) {
-		if message == "" {
-			vc.AddError(field, fmt.Sprintf("%sは必須項目です。", field))
-			return
-		}
-		vc.AddError(field, message)
+		vc.AddErrorCode(field, CodeRequired, message, nil)
 	}
 }
 
@@ -36,6 +34,7 @@ func indirect(value interface{}) (interface{}, bool) {
 }
 
 // isEmpty checks if a value is considered empty.
+// A struct with an IsZero method, such as time.Time, is empty if it reports so.
 func isEmpty(value interface{}) bool {
 	if value == nil {
 		return true
@@ -54,6 +53,10 @@ func isEmpty(value interface{}) bool {
 		return v.Float() == 0
 	case reflect.Interface, reflect.Ptr:
 		return v.IsNil()
+	case reflect.Struct:
+		if z, ok := value.(interface{ IsZero() bool }); ok {
+			return z.IsZero()
+		}
 	}
 	return false
 }
@@ -1,8 +1,6 @@
 package validationcontext
 
 import (
-	"fmt"
-	"os"
 	"regexp"
 	"unicode"
 	"unicode/utf8"
@@ -12,40 +10,29 @@ import (
 
 // ValidateMinLength checks if the value has at least minLen characters.
 func (vc *ValidationContext) ValidateMinLength(value string, field string, min int, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if utf8.RuneCountInString(value) < min {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sは%d文字以上で入力してください。", field, min))
+		vc.AddErrorCode(field, CodeMinLength, errMsg, map[string]interface{}{"min": min})
 	}
 }
 
 // ValidateMaxLength checks if the value has at most maxLen characters.
 func (vc *ValidationContext) ValidateMaxLength(value string, field string, max int, errMsg string) {
-	if utf8.RuneCountInString(value) > max {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sは%d文字以内で入力してください。", field, max))
+	if vc.ShouldSkip(field) {
+		return
 	}
-}
-
-// ValidateEmail checks if the value is a valid email format.
-func (vc *ValidationContext) ValidateEmail(value string, field string, errMsg string) {
-	re := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-	if !re.MatchString(value) {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効なメールアドレスを指定してください。", field))
+	if utf8.RuneCountInString(value) > max {
+		vc.AddErrorCode(field, CodeMaxLength, errMsg, map[string]interface{}{"max": max})
 	}
 }
 
 // ValidateContainsSpecial checks if the value contains at least one special character.
 func (vc *ValidationContext) ValidateContainsSpecial(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	hasSpecial := false
 	for _, char := range value {
 		if unicode.IsPunct(char) || unicode.IsSymbol(char) {
@@ -56,26 +43,24 @@ func (vc *ValidationContext) ValidateContainsSpecial(value, field, errMsg string
 	if hasSpecial {
 		return
 	}
-	if errMsg != "" {
-		vc.AddError(field, errMsg)
-		return
-	}
-	vc.AddError(field, fmt.Sprintf("%sには、特殊文字を含めてください。", field))
+	vc.AddErrorCode(field, CodeContainsSpecial, errMsg, nil)
 }
 
 func (vc *ValidationContext) ValidateContainsSpecialRegx(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	re := regexp.MustCompile(`[!@#~$%^&*(),.?":{}|<>]`)
 	if !re.MatchString(value) {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、特殊文字を含めてください。", field))
+		vc.AddErrorCode(field, CodeContainsSpecial, errMsg, nil)
 	}
 }
 
 // ValidateContainsNumber checks if the value contains at least one number.
 func (vc *ValidationContext) ValidateContainsNumber(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	hasNumber := false
 	for _, char := range value {
 		if unicode.IsDigit(char) {
@@ -86,80 +71,54 @@ func (vc *ValidationContext) ValidateContainsNumber(value, field, errMsg string)
 	if hasNumber {
 		return
 	}
-	if errMsg != "" {
-		vc.AddError(field, errMsg)
-		return
-	}
-	vc.AddError(field, fmt.Sprintf("%sには、数字を含めてください。", field))
+	vc.AddErrorCode(field, CodeContainsNumber, errMsg, nil)
 }
 
 func (vc *ValidationContext) ValidateContainsNumberRegx(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	re := regexp.MustCompile(`[0-9]`)
 	if !re.MatchString(value) {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、数字を含めてください。", field))
+		vc.AddErrorCode(field, CodeContainsNumber, errMsg, nil)
 	}
 }
 
 // ValidateContainsUppercase checks if the value contains at least one uppercase letter.
 func (vc *ValidationContext) ValidateContainsUppercase(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	re := regexp.MustCompile(`[A-Z]`)
 	if !re.MatchString(value) {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、大文字の英字を含めてください。", field))
+		vc.AddErrorCode(field, CodeContainsUpper, errMsg, nil)
 	}
 }
 
 // ValidateContainsLowercase checks if the value contains at least one lowercase letter.
 func (vc *ValidationContext) ValidateContainsLowercase(value, field, errMsg string) {
-	re := regexp.MustCompile(`[a-z]`)
-	if !re.MatchString(value) {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、小文字の英字を含めてください。", field))
+	if vc.ShouldSkip(field) {
+		return
 	}
-}
-
-// ValidateURL checks if the value is a valid URL.
-func (vc *ValidationContext) ValidateURL(value, field, errMsg string) {
-	re := regexp.MustCompile(`^(https?|ftp)://[^\s/$.?#].[^\s]*$`)
+	re := regexp.MustCompile(`[a-z]`)
 	if !re.MatchString(value) {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効なURLを指定してください。", field))
+		vc.AddErrorCode(field, CodeContainsLower, errMsg, nil)
 	}
 }
 
 // ValidateFile checks if the value is a valid file path.
+//
+// Deprecated: Use ValidateFilePath, which it calls.
 func (vc *ValidationContext) ValidateFile(value, field, errMsg string) {
-	if _, err := os.Stat(value); err != nil {
-		if os.IsNotExist(err) {
-			if errMsg != "" {
-				vc.AddError(field, errMsg)
-				return
-			}
-			vc.AddError(field, fmt.Sprintf("%sには、有効なファイルパスを指定してください。", field))
-		}
-	}
+	vc.ValidateFilePath(value, field, errMsg)
 }
 
 // ValidateUUID checks if the value is a valid UUID.
 func (vc *ValidationContext) ValidateUUID(value, field, errMsg string) {
+	if vc.ShouldSkip(field) {
+		return
+	}
 	if _, err := uuid.Parse(value); err != nil {
-		if errMsg != "" {
-			vc.AddError(field, errMsg)
-			return
-		}
-		vc.AddError(field, fmt.Sprintf("%sには、有効なUUIDを指定してください。", field))
+		vc.AddErrorCode(field, CodeUUID, errMsg, nil)
 	}
 }
@@ -0,0 +1,477 @@
+package validationcontext
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// tagName is the struct tag read by ValidateStruct.
+const tagName = "validate"
+
+// tagRuleFunc applies a struct tag rule to a field value.
+// value is never a nil pointer; pointers are dereferenced before the rule is applied.
+type tagRuleFunc func(vc *ValidationContext, field string, value reflect.Value, param string)
+
+// builtinTagRule is a rule usable in struct tags. check returns an error if
+// the rule does not support values of type t, which is never a pointer, or if
+// param is malformed; it is called when a struct type is first parsed, so that
+// apply does not fail during validation, and by applyRule for the dynamic type
+// of interface values.
+type builtinTagRule struct {
+	check func(t reflect.Type, param string) error
+	apply tagRuleFunc
+}
+
+// tagRules maps rule names usable in struct tags to their implementations.
+// "required", "omitempty" and the conditional requirements are handled by
+// validateField, because they also apply to nil pointers and empty values.
+var tagRules = map[string]builtinTagRule{
+	"min": {check: checkBoundTagRule("min"), apply: func(vc *ValidationContext, field string, value reflect.Value, param string) {
+		switch value.Kind() {
+		case reflect.String:
+			vc.ValidateMinLength(value.String(), field, atoiParam("min", param), "")
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if min := atoiParam("min", param); !vc.ShouldSkip(field) && value.Len() < min {
+				vc.AddErrorCode(field, CodeMinItems, "", map[string]interface{}{"min": min})
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			ValidateMin(vc, value.Int(), field, parseIntParam("min", param), "")
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			ValidateMin(vc, value.Uint(), field, parseUintParam("min", param), "")
+		case reflect.Float32, reflect.Float64:
+			ValidateMin(vc, value.Float(), field, parseFloatParam("min", param), "")
+		default:
+			panic(fmt.Sprintf("validationcontext: rule %q does not support %s fields", "min", value.Type()))
+		}
+	}},
+	"max": {check: checkBoundTagRule("max"), apply: func(vc *ValidationContext, field string, value reflect.Value, param string) {
+		switch value.Kind() {
+		case reflect.String:
+			vc.ValidateMaxLength(value.String(), field, atoiParam("max", param), "")
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if max := atoiParam("max", param); !vc.ShouldSkip(field) && value.Len() > max {
+				vc.AddErrorCode(field, CodeMaxItems, "", map[string]interface{}{"max": max})
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			ValidateMax(vc, value.Int(), field, parseIntParam("max", param), "")
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			ValidateMax(vc, value.Uint(), field, parseUintParam("max", param), "")
+		case reflect.Float32, reflect.Float64:
+			ValidateMax(vc, value.Float(), field, parseFloatParam("max", param), "")
+		default:
+			panic(fmt.Sprintf("validationcontext: rule %q does not support %s fields", "max", value.Type()))
+		}
+	}},
+	"email":              stringTagRule("email", (*ValidationContext).ValidateEmail),
+	"url":                stringTagRule("url", (*ValidationContext).ValidateURL),
+	"uuid":               stringTagRule("uuid", (*ValidationContext).ValidateUUID),
+	"date":               stringTagRule("date", (*ValidationContext).ValidateDate),
+	"year_month":         stringTagRule("year_month", (*ValidationContext).ValidateYearMonth),
+	"year":               stringTagRule("year", (*ValidationContext).ValidateYear),
+	"month":              stringTagRule("month", (*ValidationContext).ValidateMonth),
+	"datetime":           stringTagRule("datetime", (*ValidationContext).ValidateDateTime),
+	"time":               stringTagRule("time", (*ValidationContext).ValidateTime),
+	"contains_special":   stringTagRule("contains_special", (*ValidationContext).ValidateContainsSpecial),
+	"contains_number":    stringTagRule("contains_number", (*ValidationContext).ValidateContainsNumber),
+	"contains_uppercase": stringTagRule("contains_uppercase", (*ValidationContext).ValidateContainsUppercase),
+	"contains_lowercase": stringTagRule("contains_lowercase", (*ValidationContext).ValidateContainsLowercase),
+}
+
+// checkBoundTagRule checks "min" and "max": a length for strings, slices,
+// arrays and maps, and a bound of the field's kind for numbers.
+func checkBoundTagRule(name string) func(t reflect.Type, param string) error {
+	return func(t reflect.Type, param string) error {
+		var err error
+		switch t.Kind() {
+		case reflect.Interface:
+			return nil
+		case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+			_, err = strconv.Atoi(param)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			_, err = strconv.ParseInt(param, 10, 64)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			_, err = strconv.ParseUint(param, 10, 64)
+		case reflect.Float32, reflect.Float64:
+			_, err = strconv.ParseFloat(param, 64)
+		default:
+			return fmt.Errorf("rule %q does not support %s fields", name, t)
+		}
+		if err != nil {
+			return fmt.Errorf("rule %q requires a numeric parameter for %s fields, got %q", name, t, param)
+		}
+		return nil
+	}
+}
+
+func stringTagRule(name string, validate func(vc *ValidationContext, value, field, errMsg string)) builtinTagRule {
+	return builtinTagRule{
+		check: func(t reflect.Type, param string) error {
+			if t.Kind() != reflect.String && t.Kind() != reflect.Interface {
+				return fmt.Errorf("rule %q requires a string field, got %s", name, t)
+			}
+			return nil
+		},
+		apply: func(vc *ValidationContext, field string, value reflect.Value, param string) {
+			if value.Kind() != reflect.String {
+				panic(fmt.Sprintf("validationcontext: rule %q requires a string field, got %s", name, value.Type()))
+			}
+			validate(vc, value.String(), field, "")
+		},
+	}
+}
+
+func atoiParam(rule, param string) int {
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		panic(fmt.Sprintf("validationcontext: rule %q requires an integer parameter, got %q", rule, param))
+	}
+	return n
+}
+
+func parseIntParam(rule, param string) int64 {
+	n, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("validationcontext: rule %q requires an integer parameter, got %q", rule, param))
+	}
+	return n
+}
+
+func parseUintParam(rule, param string) uint64 {
+	n, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		panic(fmt.Sprintf("validationcontext: rule %q requires an unsigned integer parameter, got %q", rule, param))
+	}
+	return n
+}
+
+func parseFloatParam(rule, param string) float64 {
+	f, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		panic(fmt.Sprintf("validationcontext: rule %q requires a numeric parameter, got %q", rule, param))
+	}
+	return f
+}
+
+type tagRule struct {
+	name  string
+	param string
+}
+
+type structField struct {
+	index      int
+	name       string
+	embedded   bool
+	required   bool
+	omitEmpty  bool
+	conditions []tagRule
+	rules      []tagRule
+}
+
+// structCache caches the parsed fields of each struct type.
+var structCache sync.Map // map[reflect.Type][]structField
+
+// ValidateStruct validates v according to its `validate` struct tags, e.g.
+//
+//	Name  string `json:"name" validate:"required,min=3,max=50"`
+//	Email string `json:"email" validate:"omitempty,email"`
+//
+// Rules are separated by commas and parameters follow "=". "min" and "max"
+// bound the length of strings, the number of elements of slices, arrays and
+// maps, and the value of numbers. Fields are reported by their json name, or
+// by their Go name when there is none. Nested structs, slices, arrays and maps
+// are validated recursively with their paths, e.g. "items[3].qty"; a pointer
+// back to a value being validated, as in a cyclic list, is not followed again.
+// Nil pointers are only checked by "required", the same way as Required.
+// A tag of "-" skips the field. v must be a struct or a pointer to one.
+//
+// Tags are checked when a struct type is first validated: an unknown rule, a
+// rule that does not support the field type or a malformed parameter panics,
+// even if the field is empty.
+//
+// Rules registered with RegisterRule are available as well; their arguments
+// are separated by spaces, e.g. `validate:"employee_code=EMP 6"`.
+//
+// Conditional requirements refer to a sibling field by its Go name:
+//
+//	InvoiceAddress string `validate:"required_if=BillingType company"`
+//	Phone          string `validate:"required_without=Email"`
+//
+// "required_if" and "required_unless" compare the sibling, formatted with
+// fmt.Sprint, with the given value; "required_with" and "required_without"
+// check whether the sibling is present.
+func (vc *ValidationContext) ValidateStruct(v interface{}) {
+	rv, isNil := indirectValue(reflect.ValueOf(v))
+	if isNil {
+		return
+	}
+	if rv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validationcontext: ValidateStruct expects a struct, got %T", v))
+	}
+	vc.descend(reflect.ValueOf(v), make(map[pointerVisit]bool))
+}
+
+// pointerVisit identifies the value a pointer refers to. The type is part of
+// the key because a struct and its first field share their address.
+type pointerVisit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func (vc *ValidationContext) validateStruct(rv reflect.Value, ancestors map[pointerVisit]bool) {
+	for _, f := range cachedStructFields(rv.Type()) {
+		fv := rv.Field(f.index)
+		if f.embedded {
+			if ev, isNil := indirectValue(fv); !isNil && ev.Kind() == reflect.Struct {
+				vc.descend(fv, ancestors)
+			}
+			continue
+		}
+		vc.validateField(f, fv, rv, ancestors)
+	}
+}
+
+func (vc *ValidationContext) validateField(f structField, fv, parent reflect.Value, ancestors map[pointerVisit]bool) {
+	if f.required {
+		vc.Required(fv.Interface(), f.name, "", false)
+	}
+	for _, cond := range f.conditions {
+		vc.validateCondition(f, fv, parent, cond)
+	}
+	value, isNil := indirectValue(fv)
+	if isNil || (f.omitEmpty && isEmpty(value.Interface())) {
+		return
+	}
+	for _, rule := range f.rules {
+		vc.applyRule(f.name, rule, fv, value)
+	}
+	if isNestable(value) {
+		vc.Scope(f.name).descend(fv, ancestors)
+	}
+}
+
+// validateCondition applies a conditional requirement that refers to a sibling field.
+func (vc *ValidationContext) validateCondition(f structField, fv, parent reflect.Value, cond tagRule) {
+	otherName, expected, _ := strings.Cut(cond.param, " ")
+	sf, ok := parent.Type().FieldByName(otherName)
+	if !ok || !sf.IsExported() {
+		panic(fmt.Sprintf("validationcontext: rule %q on field %s refers to unknown field %q", cond.name, f.name, otherName))
+	}
+	other := parent.FieldByIndex(sf.Index)
+	label := fieldName(sf)
+
+	value := fv.Interface()
+	switch cond.name {
+	case "required_if":
+		vc.requiredWhen(value, f.name, matchesParam(other, expected), CodeRequiredIf,
+			map[string]interface{}{"other": label, "value": expected}, "")
+	case "required_unless":
+		vc.requiredWhen(value, f.name, !matchesParam(other, expected), CodeRequiredUnless,
+			map[string]interface{}{"other": label, "value": expected}, "")
+	case "required_with":
+		vc.requiredWhen(value, f.name, isPresent(other.Interface()), CodeRequiredWith,
+			map[string]interface{}{"other": label}, "")
+	case "required_without":
+		vc.requiredWhen(value, f.name, !isPresent(other.Interface()), CodeRequiredWithout,
+			map[string]interface{}{"other": label}, "")
+	}
+}
+
+// matchesParam reports whether the value equals param, compared with equalValues.
+func matchesParam(value reflect.Value, param string) bool {
+	v, isNil := indirectValue(value)
+	return !isNil && equalValues(v.Interface(), param)
+}
+
+// descend dereferences v and validates the value it refers to with
+// validateNested. ancestors holds the pointers followed by the enclosing calls;
+// a pointer among them closes a cycle and is not followed again.
+func (vc *ValidationContext) descend(v reflect.Value, ancestors map[pointerVisit]bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Ptr {
+			visit := pointerVisit{ptr: v.Pointer(), typ: v.Type()}
+			if ancestors[visit] {
+				return
+			}
+			ancestors[visit] = true
+			defer delete(ancestors, visit)
+		}
+		v = v.Elem()
+	}
+	vc.validateNested(v, ancestors)
+}
+
+// validateNested recurses into structs and into the elements of slices, arrays and maps.
+func (vc *ValidationContext) validateNested(value reflect.Value, ancestors map[pointerVisit]bool) {
+	switch value.Kind() {
+	case reflect.Struct:
+		vc.validateStruct(value, ancestors)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if elem, isNil := indirectValue(value.Index(i)); !isNil && isNestable(elem) {
+				vc.Index(i).descend(value.Index(i), ancestors)
+			}
+		}
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			if elem, isNil := indirectValue(value.MapIndex(key)); !isNil && isNestable(elem) {
+				vc.Key(fmt.Sprint(key.Interface())).descend(value.MapIndex(key), ancestors)
+			}
+		}
+	}
+}
+
+func isNestable(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
+
+// indirectValue dereferences pointers and interfaces like indirect, but works on reflect values.
+func indirectValue(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, true
+		}
+		v = v.Elem()
+	}
+	return v, !v.IsValid()
+}
+
+func cachedStructFields(t reflect.Type) []structField {
+	if fields, ok := structCache.Load(t); ok {
+		return fields.([]structField)
+	}
+	parsed := make(map[reflect.Type][]structField)
+	parseStructTypes(t, parsed)
+	for pt, fields := range parsed {
+		structCache.LoadOrStore(pt, fields)
+	}
+	fields, _ := structCache.Load(t)
+	return fields.([]structField)
+}
+
+// parseStructTypes parses t and the uncached struct types reachable from its
+// fields into parsed, so that the tags of nested structs are checked when the
+// outer type is first validated, even if the nested values are nil.
+func parseStructTypes(t reflect.Type, parsed map[reflect.Type][]structField) {
+	if _, ok := parsed[t]; ok {
+		return
+	}
+	if _, ok := structCache.Load(t); ok {
+		return
+	}
+	parsed[t] = parseStructFields(t)
+	for _, f := range parsed[t] {
+		if nested := nestedStructType(t.Field(f.index).Type); nested != nil {
+			parseStructTypes(nested, parsed)
+		}
+	}
+}
+
+// nestedStructType returns the struct type that validateNested reaches through
+// pointers, slices, arrays and maps of type t, or nil if there is none.
+func nestedStructType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		case reflect.Struct:
+			return t
+		default:
+			return nil
+		}
+	}
+}
+
+func parseStructFields(t reflect.Type) []structField {
+	fields := make([]structField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		if sf.Anonymous && sf.IsExported() && tag == "" {
+			fields = append(fields, structField{index: i, name: sf.Name, embedded: true})
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		f := structField{index: i, name: fieldName(sf)}
+		parseTagRules(&f, tag)
+		checkTagRules(f, sf.Type)
+		for _, cond := range f.conditions {
+			otherName, _, _ := strings.Cut(cond.param, " ")
+			if other, ok := t.FieldByName(otherName); !ok || !other.IsExported() {
+				panic(fmt.Sprintf("validationcontext: rule %q on field %s refers to unknown field %q", cond.name, f.name, otherName))
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// parseTagRules adds the rules of a `validate` tag to f.
+func parseTagRules(f *structField, tag string) {
+	for _, item := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(item), "=")
+		switch name {
+		case "":
+		case "required":
+			f.required = true
+		case "omitempty":
+			f.omitEmpty = true
+		case "required_if", "required_unless", "required_with", "required_without":
+			f.conditions = append(f.conditions, tagRule{name: name, param: param})
+		default:
+			f.rules = append(f.rules, tagRule{name: name, param: param})
+		}
+	}
+}
+
+// checkTagRules panics if a rule of f is unknown, or if it does not support
+// values of type t or has a malformed parameter. t is nil for a nil value,
+// in which case only the rule names are checked.
+func checkTagRules(f structField, t reflect.Type) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for _, rule := range f.rules {
+		builtin, ok := tagRules[rule.name]
+		if !ok {
+			if _, ok := lookupRule(rule.name); !ok {
+				panic(fmt.Sprintf("validationcontext: unknown rule %q on field %s", rule.name, f.name))
+			}
+			continue
+		}
+		if t == nil {
+			continue
+		}
+		if err := builtin.check(t, rule.param); err != nil {
+			panic(fmt.Sprintf("validationcontext: %v on field %s", err, f.name))
+		}
+	}
+}
+
+// fieldName returns the json name of the field, or its Go name if there is none.
+func fieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+	return name
+}
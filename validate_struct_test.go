@@ -0,0 +1,249 @@
+package validationcontext
+
+import (
+	"reflect"
+	"testing"
+)
+
+type structTestAddress struct {
+	Street string `json:"street" validate:"required,max=10"`
+	City   string `validate:"required"`
+}
+
+type structTestItem struct {
+	Name     string `json:"name" validate:"required"`
+	Quantity int    `json:"qty" validate:"min=1,max=10"`
+}
+
+type StructTestAudit struct {
+	CreatedBy string `json:"created_by" validate:"required"`
+}
+
+type structTestOrder struct {
+	StructTestAudit
+	ID       string                        `json:"id" validate:"required,uuid"`
+	Email    string                        `json:"email" validate:"omitempty,email"`
+	Note     *string                       `json:"note" validate:"min=3"`
+	Code     *string                       `json:"code" validate:"required"`
+	Address  structTestAddress             `json:"address"`
+	Billing  *structTestAddress            `json:"billing"`
+	Items    []structTestItem              `json:"items" validate:"required"`
+	Labels   map[string]*structTestAddress `json:"labels"`
+	Internal string                        `validate:"-"`
+	ignored  string
+}
+
+func TestValidateStruct(t *testing.T) {
+	short := "ab"
+	tests := []struct {
+		name       string
+		value      interface{}
+		wantFields []string
+		wantCodes  []string
+	}{
+		{
+			name: "Valid",
+			value: &structTestOrder{
+				StructTestAudit: StructTestAudit{CreatedBy: "admin"},
+				ID:              "123e4567-e89b-12d3-a456-426614174000",
+				Code:            &short,
+				Address:         structTestAddress{Street: "Main St", City: "Tokyo"},
+				Items:           []structTestItem{{Name: "pen", Quantity: 1}},
+			},
+		},
+		{
+			name: "Invalid",
+			value: structTestOrder{
+				ID:      "invalid",
+				Email:   "invalid",
+				Note:    &short,
+				Address: structTestAddress{Street: "Very Long Street"},
+				Billing: &structTestAddress{},
+				Items:   []structTestItem{{Name: "pen", Quantity: 1}, {Quantity: 11}},
+				Labels:  map[string]*structTestAddress{"b": {City: "Osaka"}, "a": nil},
+			},
+			wantFields: []string{
+				"created_by", "id", "email", "note", "code",
+				"address.street", "address.City",
+				"billing.street", "billing.City",
+				"items[1].name", "items[1].qty",
+				"labels[b].street",
+			},
+			wantCodes: []string{
+				CodeRequired, CodeUUID, CodeEmail, CodeMinLength, CodeRequired,
+				CodeMaxLength, CodeRequired,
+				CodeRequired, CodeRequired,
+				CodeRequired, CodeMaxValue,
+				CodeRequired,
+			},
+		},
+		{
+			name:       "EmptySlice",
+			value:      structTestOrder{ID: "123e4567-e89b-12d3-a456-426614174000", Code: &short, Address: structTestAddress{Street: "a", City: "b"}, StructTestAudit: StructTestAudit{CreatedBy: "admin"}},
+			wantFields: []string{"items"},
+			wantCodes:  []string{CodeRequired},
+		},
+		{
+			name:  "NilPointer",
+			value: (*structTestOrder)(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidateStruct(tt.value)
+
+			var fields, codes []string
+			for _, err := range vc.Errors() {
+				fields = append(fields, err.Field)
+				codes = append(codes, err.Code)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("Expected fields: %v, got: %v", tt.wantFields, fields)
+			}
+			if !reflect.DeepEqual(codes, tt.wantCodes) {
+				t.Errorf("Expected codes: %v, got: %v", tt.wantCodes, codes)
+			}
+		})
+	}
+}
+
+func TestValidateStructScoped(t *testing.T) {
+	vc := NewValidationContext()
+	vc.Scope("orders").Index(2).ValidateStruct(structTestItem{})
+
+	errs := vc.Errors()
+	if len(errs) != 2 {
+		t.Fatalf("Expected error count: 2, got: %v", len(errs))
+	}
+	if errs[0].Field != "orders[2].name" {
+		t.Errorf("Expected field: orders[2].name, got: %v", errs[0].Field)
+	}
+}
+
+func TestValidateStructPanics(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"NotAStruct", "string"},
+		{"UnknownRule", struct {
+			Name string `validate:"unknown"`
+		}{Name: "a"}},
+		{"StringRuleOnInt", struct {
+			Age int `validate:"email"`
+		}{Age: 1}},
+		{"InvalidParam", struct {
+			Name string `validate:"min=abc"`
+		}{Name: "a"}},
+		{"UnsupportedKindOnNil", struct {
+			Flag *bool `validate:"min=1"`
+		}{}},
+		{"InvalidParamOnEmptySlice", struct {
+			Tags []int `validate:"max=x"`
+		}{}},
+		{"NegativeParamOnUint", struct {
+			Count uint `validate:"min=-1"`
+		}{}},
+		{"UnknownRuleOnEmpty", struct {
+			Name string `validate:"omitempty,unknown"`
+		}{}},
+		{"UnknownSibling", struct {
+			Phone string `validate:"required_without=Missing"`
+		}{Phone: "1"}},
+		{"NilNestedStruct", struct {
+			Child *struct {
+				Age int `validate:"email"`
+			}
+		}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected a panic, but got none")
+				}
+			}()
+			NewValidationContext().ValidateStruct(tt.value)
+		})
+	}
+}
+
+func TestValidateStructInterfaceField(t *testing.T) {
+	type form struct {
+		V interface{} `validate:"min=3"`
+		E interface{} `validate:"email"`
+	}
+	tests := []struct {
+		name      string
+		value     form
+		wantCodes []string
+	}{
+		{"Supported", form{V: "abcd", E: "a@example.com"}, nil},
+		{"TooShort", form{V: "ab", E: "a@example.com"}, []string{CodeMinLength}},
+		{"UnsupportedKinds", form{V: true, E: 1}, []string{CodeCustom, CodeCustom}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidateStruct(tt.value)
+			errs := vc.Errors()
+			if len(errs) != len(tt.wantCodes) {
+				t.Fatalf("Expected codes: %v, got: %v", tt.wantCodes, errs)
+			}
+			for i, code := range tt.wantCodes {
+				if errs[i].Code != code {
+					t.Errorf("Expected code: %v, got: %v", code, errs[i].Code)
+				}
+			}
+		})
+	}
+}
+
+type structTestNode struct {
+	Name string          `json:"name" validate:"required"`
+	Next *structTestNode `json:"next"`
+}
+
+func TestValidateStructCycle(t *testing.T) {
+	a := &structTestNode{Name: "a"}
+	b := &structTestNode{Next: a}
+	a.Next = b
+
+	vc := NewValidationContext()
+	vc.ValidateStruct(a)
+
+	errs := vc.Errors()
+	if len(errs) != 1 || errs[0].Field != "next.name" {
+		t.Errorf("Expected a single error on next.name, got: %v", errs)
+	}
+}
+
+func TestValidateStructItems(t *testing.T) {
+	type tagged struct {
+		Tags   []string       `json:"tags" validate:"min=1,max=2"`
+		Scores [3]int         `json:"scores" validate:"max=2"`
+		Meta   map[string]int `json:"meta" validate:"omitempty,max=1"`
+	}
+
+	vc := NewValidationContext()
+	vc.ValidateStruct(tagged{Meta: map[string]int{"a": 1, "b": 2}})
+
+	var codes []string
+	for _, err := range vc.Errors() {
+		codes = append(codes, err.Field+":"+err.Code)
+	}
+	want := []string{"tags:" + CodeMinItems, "scores:" + CodeMaxItems, "meta:" + CodeMaxItems}
+	if !reflect.DeepEqual(codes, want) {
+		t.Errorf("Expected: %v, got: %v", want, codes)
+	}
+
+	vc = NewValidationContext()
+	vc.ValidateStruct(tagged{Tags: []string{"a", "b"}, Meta: map[string]int{"a": 1}})
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeMaxItems {
+		t.Errorf("Expected a single max_items error on scores, got: %v", errs)
+	}
+}
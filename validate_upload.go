@@ -0,0 +1,256 @@
+package validationcontext
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"mime/multipart"
+	"sync"
+)
+
+// Upload is file content that does not live in an *os.File, such as an HTTP
+// upload or an in-memory buffer. It is created by FileHeaderUpload,
+// MultipartFileUpload, BytesUpload, ReaderAtUpload or ReaderUpload and
+// validated with the ValidateUpload* methods, which behave like their
+// *os.File counterparts. The methods skip a nil upload, which Required reports.
+type Upload struct {
+	name string
+	// size is the size of the content, or -1 if it is only known by reading it.
+	size int64
+	open func() (io.ReaderAt, io.Closer, error)
+	// buffered is set for uploads created by ReaderUpload.
+	buffered *bufferedReader
+}
+
+// FileHeaderUpload returns the upload described by a multipart file header.
+// The name is the Filename of the header and the size is read from the header;
+// the content is opened by the validators that need it. It returns nil for a nil header.
+func FileHeaderUpload(header *multipart.FileHeader) *Upload {
+	if header == nil {
+		return nil
+	}
+	return &Upload{
+		name: header.Filename,
+		size: header.Size,
+		open: func() (io.ReaderAt, io.Closer, error) {
+			file, err := header.Open()
+			if err != nil {
+				return nil, nil, err
+			}
+			return file, file, nil
+		},
+	}
+}
+
+// MultipartFileUpload returns the upload of an opened multipart file.
+// Its size is determined by seeking to its end; the offset of the file is
+// restored. It returns nil for a nil file.
+func MultipartFileUpload(name string, file multipart.File) *Upload {
+	if file == nil {
+		return nil
+	}
+	u := &Upload{name: name, size: -1, open: func() (io.ReaderAt, io.Closer, error) {
+		return file, nil, nil
+	}}
+	if offset, err := file.Seek(0, io.SeekCurrent); err == nil {
+		if size, err := file.Seek(0, io.SeekEnd); err == nil {
+			u.size = size
+		}
+		file.Seek(offset, io.SeekStart)
+	}
+	if u.size < 0 {
+		u.buffered = &bufferedReader{r: io.NewSectionReader(file, 0, math.MaxInt64)}
+		u.open = u.buffered.open
+	}
+	return u
+}
+
+// BytesUpload returns the upload of in-memory content.
+func BytesUpload(name string, data []byte) *Upload {
+	return ReaderAtUpload(name, bytes.NewReader(data), int64(len(data)))
+}
+
+// ReaderAtUpload returns the upload of size bytes readable from r.
+func ReaderAtUpload(name string, r io.ReaderAt, size int64) *Upload {
+	if r == nil {
+		return nil
+	}
+	return &Upload{name: name, size: size, open: func() (io.ReaderAt, io.Closer, error) {
+		return r, nil, nil
+	}}
+}
+
+// ReaderUpload returns the upload of the content of r, whose size is unknown.
+// r is read at most once: the bytes read by the validators are kept in memory,
+// so that the following validators see the same content. ValidateUploadSize
+// reads at most maxSize+1 bytes.
+func ReaderUpload(name string, r io.Reader) *Upload {
+	if r == nil {
+		return nil
+	}
+	buffered := &bufferedReader{r: r}
+	return &Upload{name: name, size: -1, open: buffered.open, buffered: buffered}
+}
+
+// Name returns the file name of the upload.
+func (u *Upload) Name() string {
+	if u == nil {
+		return ""
+	}
+	return u.name
+}
+
+// sizeAtMost returns the size of the upload, reading at most limit+1 bytes if
+// it is unknown. A size above limit may then only be a lower bound.
+func (u *Upload) sizeAtMost(limit int64) (int64, error) {
+	if u.size >= 0 {
+		return u.size, nil
+	}
+	if limit < math.MaxInt64 {
+		limit++
+	}
+	return u.buffered.fill(limit)
+}
+
+// readerAt opens the content of the upload. The returned function releases it.
+func (u *Upload) readerAt() (io.ReaderAt, int64, func(), error) {
+	r, closer, err := u.open()
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	size := u.size
+	if size < 0 {
+		size = math.MaxInt64
+	}
+	release := func() {
+		if closer != nil {
+			closer.Close()
+		}
+	}
+	return r, size, release, nil
+}
+
+// ValidateUploadExtension checks if the upload has a valid extension, see ValidateFileExtension.
+func (vc *ValidationContext) ValidateUploadExtension(u *Upload, field string, validExtensions []string, errMsg string) {
+	if vc.ShouldSkip(field) || u == nil {
+		return
+	}
+	vc.validateExtension(field, u.name, validExtensions, errMsg)
+}
+
+// ValidateUploadSize checks if the upload size is within the specified limit,
+// see ValidateFileSize. The size is taken from the multipart header when there
+// is one; content of unknown size is read up to maxSize+1 bytes.
+func (vc *ValidationContext) ValidateUploadSize(u *Upload, field string, maxSize int64, errMsg string) {
+	if vc.ShouldSkip(field) || u == nil {
+		return
+	}
+	size, err := u.sizeAtMost(maxSize)
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	vc.validateSize(field, size, maxSize, errMsg)
+}
+
+// ValidateUploadContentType checks the content of the upload against the
+// allowed MIME types and its extension, see ValidateFileContentType.
+func (vc *ValidationContext) ValidateUploadContentType(u *Upload, field string, allowedTypes []string, errMsg string) {
+	if vc.ShouldSkip(field) || u == nil {
+		return
+	}
+	r, _, release, err := u.readerAt()
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	defer release()
+
+	head := make([]byte, sniffLen)
+	n, err := r.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	vc.validateContentType(field, u.name, head[:n], allowedTypes, errMsg)
+}
+
+// MaxUnknownImageSize is the number of bytes ValidateUploadImage reads from
+// content of unknown size, which is kept in memory.
+const MaxUnknownImageSize = 50 << 20
+
+// ValidateUploadImage checks that the upload is an image satisfying the
+// constraints, see ValidateImage. Content of unknown size larger than
+// MaxUnknownImageSize is reported with CodeFileSize without being decoded.
+func (vc *ValidationContext) ValidateUploadImage(u *Upload, field string, constraints ImageConstraints, errMsg string) {
+	if vc.ShouldSkip(field) || u == nil {
+		return
+	}
+	size, err := u.sizeAtMost(MaxUnknownImageSize)
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if u.size < 0 && size > MaxUnknownImageSize {
+		vc.validateSize(field, size, MaxUnknownImageSize, errMsg)
+		return
+	}
+	r, _, release, err := u.readerAt()
+	if err != nil {
+		vc.AddErrorCode(field, CodeFileRead, "", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	defer release()
+	vc.validateImage(io.NewSectionReader(r, 0, size), field, constraints, errMsg)
+}
+
+// bufferedReader is an io.ReaderAt over an io.Reader that keeps the bytes read so far.
+type bufferedReader struct {
+	mu  sync.Mutex
+	r   io.Reader
+	buf []byte
+	err error
+}
+
+func (b *bufferedReader) open() (io.ReaderAt, io.Closer, error) {
+	return b, nil, nil
+}
+
+// fill reads from the underlying reader until n bytes are buffered or it is
+// exhausted, and returns the number of buffered bytes.
+func (b *bufferedReader) fill(n int64) (int64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.fillLocked(n)
+}
+
+func (b *bufferedReader) fillLocked(n int64) (int64, error) {
+	if missing := n - int64(len(b.buf)); missing > 0 && b.err == nil {
+		var chunk bytes.Buffer
+		_, b.err = io.CopyN(&chunk, b.r, missing)
+		b.buf = append(b.buf, chunk.Bytes()...)
+	}
+	if b.err != nil && b.err != io.EOF {
+		return int64(len(b.buf)), b.err
+	}
+	return int64(len(b.buf)), nil
+}
+
+// ReadAt implements io.ReaderAt.
+func (b *bufferedReader) ReadAt(p []byte, off int64) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	size, err := b.fillLocked(off + int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+	if off >= size {
+		return 0, io.EOF
+	}
+	n := copy(p, b.buf[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
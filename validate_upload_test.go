@@ -0,0 +1,177 @@
+package validationcontext
+
+import (
+	"bytes"
+	"image/png"
+	"io"
+	"math"
+	"mime/multipart"
+	"strings"
+	"testing"
+)
+
+// newTestFileHeader builds a multipart file header for the given file name and content.
+func newTestFileHeader(t *testing.T, filename string, content []byte) *multipart.FileHeader {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatalf("Failed to create form file: %v", err)
+	}
+	part.Write(content)
+	w.Close()
+
+	form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("Failed to read form: %v", err)
+	}
+	t.Cleanup(func() { form.RemoveAll() })
+	return form.File["file"][0]
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += n
+	return n, err
+}
+
+func TestUploadValidators(t *testing.T) {
+	image := encodeTestImage(t, 160, 90, png.Encode)
+	header := newTestFileHeader(t, "license.png", image)
+	multipartFile, err := header.Open()
+	if err != nil {
+		t.Fatalf("Failed to open file header: %v", err)
+	}
+	defer multipartFile.Close()
+
+	uploads := map[string]func() *Upload{
+		"FileHeader":    func() *Upload { return FileHeaderUpload(header) },
+		"MultipartFile": func() *Upload { return MultipartFileUpload("license.png", multipartFile) },
+		"Bytes":         func() *Upload { return BytesUpload("license.png", image) },
+		"ReaderAt":      func() *Upload { return ReaderAtUpload("license.png", bytes.NewReader(image), int64(len(image))) },
+		"Reader":        func() *Upload { return ReaderUpload("license.png", bytes.NewReader(image)) },
+	}
+
+	for name, upload := range uploads {
+		t.Run(name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.UploadField("LicenseImage", upload()).Required().Extension(".png").MaxSize(1 << 20).
+				ContentType("image/png").Image(ImageConstraints{MinWidth: 100})
+			if vc.HasErrors() {
+				t.Fatalf("Expected no errors, got: %v", vc.Errors())
+			}
+
+			vc = NewValidationContext()
+			vc.UploadField("LicenseImage", upload()).Extension(".jpg").MaxSize(10).
+				ContentType("application/pdf").Image(ImageConstraints{MinWidth: 320})
+			wantCodes := []string{CodeFileExtension, CodeFileSize, CodeFileContentType, CodeImageTooSmall}
+			errs := vc.Errors()
+			if len(errs) != len(wantCodes) {
+				t.Fatalf("Expected codes: %v, got: %v", wantCodes, errs)
+			}
+			for i, code := range wantCodes {
+				if errs[i].Code != code {
+					t.Errorf("Expected code: %v, got: %v", code, errs[i].Code)
+				}
+			}
+		})
+	}
+
+	if offset, _ := multipartFile.Seek(0, io.SeekCurrent); offset != 0 {
+		t.Errorf("Expected the multipart file offset to be restored, got: %v", offset)
+	}
+}
+
+func TestReaderUploadBoundedRead(t *testing.T) {
+	r := &countingReader{r: strings.NewReader(strings.Repeat("a", 1<<20))}
+	u := ReaderUpload("notes.txt", r)
+
+	vc := NewValidationContext()
+	vc.ValidateUploadSize(u, "Notes", 1024, "")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeFileSize {
+		t.Fatalf("Expected a size error, got: %v", errs)
+	}
+	if r.n > 1025 {
+		t.Errorf("Expected at most 1025 bytes to be read, got: %v", r.n)
+	}
+
+	vc.ValidateUploadContentType(u, "Notes", []string{"text/plain"}, "")
+	if len(vc.Errors()) != 1 {
+		t.Errorf("Expected the buffered content to be sniffed, got: %v", vc.Errors())
+	}
+}
+
+func TestUploadRequired(t *testing.T) {
+	vc := NewValidationContext()
+	vc.UploadField("LicenseImage", FileHeaderUpload(nil)).Required().MaxSize(10).ContentType("image/png")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeRequired {
+		t.Errorf("Expected a required error, got: %v", errs)
+	}
+}
+
+func TestReaderUploadMaxInt64Size(t *testing.T) {
+	r := &countingReader{r: strings.NewReader("notes")}
+	vc := NewValidationContext()
+	vc.ValidateUploadSize(ReaderUpload("notes.txt", r), "Notes", math.MaxInt64, "")
+	if vc.HasErrors() {
+		t.Fatalf("Expected no errors, got: %v", vc.Errors())
+	}
+	if r.n != 5 {
+		t.Errorf("Expected the content to be read, got %v bytes", r.n)
+	}
+}
+
+func TestNilUpload(t *testing.T) {
+	uploads := map[string]*Upload{
+		"FileHeader":    FileHeaderUpload(nil),
+		"MultipartFile": MultipartFileUpload("license.png", nil),
+		"ReaderAt":      ReaderAtUpload("license.png", nil, 0),
+		"Reader":        ReaderUpload("license.png", nil),
+	}
+
+	for name, u := range uploads {
+		t.Run(name, func(t *testing.T) {
+			vc := NewValidationContext()
+			vc.ValidateUploadExtension(u, "LicenseImage", []string{".png"}, "")
+			vc.ValidateUploadSize(u, "LicenseImage", 10, "")
+			vc.ValidateUploadContentType(u, "LicenseImage", []string{"image/png"}, "")
+			vc.ValidateUploadImage(u, "LicenseImage", ImageConstraints{MinWidth: 100}, "")
+			vc.ValidateUploadArchive(u, "LicenseImage", ArchiveConstraints{MaxTotalSize: 10}, "")
+			if vc.HasErrors() {
+				t.Errorf("Expected a nil upload to be skipped, got: %v", vc.Errors())
+			}
+			if u.Name() != "" {
+				t.Errorf("Expected an empty name, got: %v", u.Name())
+			}
+		})
+	}
+}
+
+// zeroReader is an endless stream of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestReaderUploadImageBoundedRead(t *testing.T) {
+	image := encodeTestImage(t, 1, 1, png.Encode)
+	r := &countingReader{r: io.MultiReader(bytes.NewReader(image), zeroReader{})}
+
+	vc := NewValidationContext()
+	vc.ValidateUploadImage(ReaderUpload("license.png", r), "LicenseImage", ImageConstraints{}, "")
+	if errs := vc.Errors(); len(errs) != 1 || errs[0].Code != CodeFileSize {
+		t.Fatalf("Expected a size error, got: %v", errs)
+	}
+	if r.n > MaxUnknownImageSize+1 {
+		t.Errorf("Expected at most %v bytes to be read, got: %v", MaxUnknownImageSize+1, r.n)
+	}
+}
@@ -1,19 +1,55 @@
 package validationcontext
 
 import (
+	"encoding/json"
 	"fmt"
-	"runtime"
 	"strings"
+	"sync"
 )
 
+// ValidationError describes a single failed validation.
+// Code identifies the rule that failed and Params holds the rule arguments
+// (e.g. "min", "max", "extensions"), so that clients can build their own messages.
+//
+// Severity is SeverityError unless the error was added as a warning or notice;
+// it is omitted from JSON for errors.
+//
+// Field is the rendered path of the field (e.g. "items[3].qty") and Path holds
+// the same path as structured segments. Frames is the stack captured when the
+// error was added, without the frames of this package, and StackTrace is its
+// rendered form. Stack traces are left out of JSON, as they reveal source
+// paths; see ValidationAggregateError.MarshalJSONWithTraces.
 type ValidationError struct {
-	Field      string
-	Message    string
-	StackTrace string
+	Field      string                 `json:"field"`
+	Path       Path                   `json:"-"`
+	Code       string                 `json:"code,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+	Message    string                 `json:"message"`
+	Severity   Severity               `json:"severity,omitempty"`
+	StackTrace string                 `json:"-"`
+	Frames     []StackFrame           `json:"-"`
 }
 
+// ValidationContext collects validation errors.
+// It is safe for concurrent use by multiple goroutines.
 type ValidationContext struct {
-	errors []ValidationError
+	mu        sync.Mutex
+	errors    []ValidationError
+	truncated int
+	cfg       config
+
+	wg      sync.WaitGroup
+	pending []*ValidationContext
+	asyncs  []asyncTask
+
+	// root is the context that stores the errors of a view created by Scope,
+	// Index or Key. It is nil for a context created by NewValidationContext.
+	root     *ValidationContext
+	path     Path
+	severity Severity
+
+	// chain is set on the contexts used by rule chains such as StringRules.
+	chain *chainState
 }
 
 // ValidationAggregateError is a custom error type that aggregates multiple validation errors,
@@ -21,11 +57,17 @@ type ValidationContext struct {
 type ValidationAggregateError struct {
 	Messages    []string
 	StackTraces []string
+	Errors      []ValidationError
+	// Truncated is the number of errors discarded by WithFailFast or WithMaxErrors.
+	Truncated int
 }
 
 // Error implements the error interface for ValidationAggregateError.
 // It returns a formatted string of all validation error messages.
 func (e *ValidationAggregateError) Error() string {
+	if e.Truncated > 0 {
+		return fmt.Sprintf("Validation errors: %s; ...and %d more", strings.Join(e.Messages, "; "), e.Truncated)
+	}
 	return fmt.Sprintf("Validation errors: %s", strings.Join(e.Messages, "; "))
 }
 
@@ -39,6 +81,51 @@ func (e *ValidationAggregateError) GetStackTraces() []string {
 	return e.StackTraces
 }
 
+// MarshalJSON encodes the aggregate as its summary message and the list of errors.
+func (e *ValidationAggregateError) MarshalJSON() ([]byte, error) {
+	errs := e.Errors
+	if errs == nil {
+		errs = []ValidationError{}
+	}
+	return json.Marshal(struct {
+		Message string            `json:"message"`
+		Errors  []ValidationError `json:"errors"`
+	}{
+		Message: e.Error(),
+		Errors:  errs,
+	})
+}
+
+// MarshalJSONWithTraces encodes the aggregate like MarshalJSON, adding the
+// stack trace of each error as "trace", e.g. for logs.
+func (e *ValidationAggregateError) MarshalJSONWithTraces() ([]byte, error) {
+	type tracedError struct {
+		ValidationError
+		Trace string `json:"trace,omitempty"`
+	}
+	errs := make([]tracedError, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = tracedError{ValidationError: err, Trace: err.StackTrace}
+	}
+	return json.Marshal(struct {
+		Message string        `json:"message"`
+		Errors  []tracedError `json:"errors"`
+	}{
+		Message: e.Error(),
+		Errors:  errs,
+	})
+}
+
+// Unwrap returns each aggregated ValidationError as a *ValidationError,
+// so that errors.Is and errors.As can inspect individual failures.
+func (e *ValidationAggregateError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i := range e.Errors {
+		errs[i] = &e.Errors[i]
+	}
+	return errs
+}
+
 // GetMessagesAsString returns all stack traces as a single string.
 func (e *ValidationAggregateError) GetMessagesAsString() string {
 	return strings.Join(e.Messages, "\n")
@@ -49,66 +136,126 @@ func (e *ValidationAggregateError) GetStackTracesAsString() string {
 	return strings.Join(e.StackTraces, "\n")
 }
 
-// NewValidationContext creates and returns a new ValidationContext instance.
-func NewValidationContext() *ValidationContext {
-	return &ValidationContext{
+// NewValidationContext creates and returns a new ValidationContext instance
+// configured with the given options.
+func NewValidationContext(opts ...Option) *ValidationContext {
+	vc := &ValidationContext{
 		errors: make([]ValidationError, 0),
 	}
+	for _, opt := range opts {
+		opt(vc)
+	}
+	return vc
 }
 
 // AddError adds a validation error to the context, including the field, error message,
 // and captures the stack trace at the time the error occurred.
+// The error is recorded with CodeCustom.
 func (vc *ValidationContext) AddError(field, message string) {
-	stackTrace := vc.captureStackTrace()
-	vc.errors = append(vc.errors, ValidationError{Field: field, Message: message, StackTrace: stackTrace})
+	vc.AddErrorCode(field, CodeCustom, message, nil)
+}
+
+// AddErrorCode adds a validation error with a machine-readable code and rule parameters.
+// It is the entry point for custom rules that want to report structured errors.
+// If message is empty, the default message for the code is resolved from the catalog.
+func (vc *ValidationContext) AddErrorCode(field, code, message string, params map[string]interface{}) {
+	vc.addError(field, code, message, params, captureFrames(vc.cfg.stackTrace))
 }
 
-// Errors returns the list of validation errors that have been added to the context.
+// addError stores an error with frames captured by the caller.
+func (vc *ValidationContext) addError(field, code, message string, params map[string]interface{}, frames []StackFrame) {
+	if message == "" {
+		message = vc.Message(field, code, params)
+	}
+	path := vc.fieldPath(field)
+	if vc.chain != nil && vc.severity == SeverityError {
+		vc.chain.failed = true
+	}
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.appendLocked(ValidationError{
+		Field:      path.String(),
+		Path:       path,
+		Code:       code,
+		Params:     params,
+		Message:    message,
+		Severity:   vc.severity,
+		StackTrace: formatFrames(frames),
+		Frames:     frames,
+	})
+}
+
+// Errors returns a copy of the blocking validation errors that have been added to the context.
+// Views created by Scope, Index or Key return the errors of the whole context.
+// Warnings and notices are returned by Warnings and Issues.
 func (vc *ValidationContext) Errors() []ValidationError {
-	return vc.errors
+	return vc.filterIssues(SeverityError)
 }
 
-// HasErrors returns true if there are any validation errors in the context, otherwise false.
+// HasErrors returns true if there are any blocking validation errors in the context, otherwise false.
 func (vc *ValidationContext) HasErrors() bool {
-	return len(vc.errors) > 0
+	s := vc.store()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.countLocked(SeverityError) > 0
 }
 
 // FormatErrors returns a formatted string representation of all validation errors.
+// Errors discarded by WithFailFast or WithMaxErrors are summarized as "...and N more".
 func (vc *ValidationContext) FormatErrors() string {
-	if !vc.HasErrors() {
+	errs := vc.Errors()
+	if len(errs) == 0 {
 		return "No validation errors"
 	}
 	var sb strings.Builder
 	sb.WriteString("Validation errors:\n")
-	for _, err := range vc.Errors() {
+	for _, err := range errs {
 		sb.WriteString(fmt.Sprintf("Field: %s, Error: %s\n", err.Field, err.Message))
 	}
+	if truncated := vc.Truncated(); truncated > 0 {
+		sb.WriteString(fmt.Sprintf("...and %d more\n", truncated))
+	}
 	return sb.String()
 }
 
 // AggregateError creates and returns a ValidationAggregateError that contains
-// all validation errors, including their messages and stack traces.
+// all blocking validation errors, including their messages and stack traces.
+// It returns nil if the context only has warnings or notices.
 func (vc *ValidationContext) AggregateError() error {
-	if !vc.HasErrors() {
+	errs := vc.Errors()
+	if len(errs) == 0 {
 		return nil
 	}
 
-	messages := make([]string, len(vc.errors))
-	stackTraces := make([]string, len(vc.errors))
+	messages := make([]string, len(errs))
+	stackTraces := make([]string, len(errs))
 
-	for i, err := range vc.errors {
-		messages[i] = fmt.Sprintf("Field: %s, Error: %s", err.Field, err.Message)
+	for i, err := range errs {
+		messages[i] = err.Error()
 		stackTraces[i] = err.StackTrace
 	}
 
 	return &ValidationAggregateError{
 		Messages:    messages,
 		StackTraces: stackTraces,
+		Errors:      errs,
+		Truncated:   vc.Truncated(),
+	}
+}
+
+// fieldPath returns the full path of field within the context.
+func (vc *ValidationContext) fieldPath(field string) Path {
+	if field == "" {
+		return vc.path
 	}
+	return vc.path.Field(field)
 }
 
-func (vc *ValidationContext) captureStackTrace() string {
-	stackBuf := make([]byte, 1024)
-	n := runtime.Stack(stackBuf, false)
-	return string(stackBuf[:n])
+// store returns the context that holds the errors written through vc.
+func (vc *ValidationContext) store() *ValidationContext {
+	if vc.root != nil {
+		return vc.root
+	}
+	return vc
 }
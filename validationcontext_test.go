@@ -2,7 +2,9 @@ package validationcontext
 
 import (
 	"os"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestAddError(t *testing.T) {
@@ -607,6 +609,9 @@ func TestRequired(t *testing.T) {
 		{"NonZeroInt", 123, false, 0},
 		{"EmptySlice", []int{}, false, 1},
 		{"NonEmptySlice", []int{1, 2, 3}, false, 0},
+		{"ZeroTime", time.Time{}, false, 1},
+		{"ZeroTimePointer", &time.Time{}, false, 1},
+		{"NonZeroTime", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false, 0},
 	}
 
 	for _, tt := range tests {
@@ -673,3 +678,70 @@ func TestValidateFileSize(t *testing.T) {
 		})
 	}
 }
+
+func TestAddErrorCode(t *testing.T) {
+	vc := NewValidationContext()
+	vc.AddErrorCode("Field1", "employee_code", "Error1", map[string]interface{}{"length": 6})
+
+	errs := vc.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if errs[0].Code != "employee_code" || errs[0].Params["length"] != 6 {
+		t.Errorf("Unexpected error: %v", errs[0])
+	}
+	if errs[0].StackTrace == "" {
+		t.Error("Expected a stack trace, but got an empty string")
+	}
+
+	vc.AddError("Field2", "Error2")
+	if got := vc.Errors()[1].Code; got != CodeCustom {
+		t.Errorf("Expected code %s, got %s", CodeCustom, got)
+	}
+}
+
+func TestValidatorErrorCodes(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "testfile*.txt")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(make([]byte, 10)); err != nil {
+		t.Fatalf("Failed to write to temporary file: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		validate   func(vc *ValidationContext)
+		wantCode   string
+		wantParams map[string]interface{}
+	}{
+		{"Required", func(vc *ValidationContext) { vc.Required("", "Field1", "", false) }, CodeRequired, nil},
+		{"MinLength", func(vc *ValidationContext) { vc.ValidateMinLength("ab", "Field1", 3, "") }, CodeMinLength, map[string]interface{}{"min": 3}},
+		{"MaxLength", func(vc *ValidationContext) { vc.ValidateMaxLength("abcd", "Field1", 3, "") }, CodeMaxLength, map[string]interface{}{"max": 3}},
+		{"Email", func(vc *ValidationContext) { vc.ValidateEmail("invalid", "Field1", "custom message") }, CodeEmail, nil},
+		{"MinValue", func(vc *ValidationContext) { vc.ValidateMinValue(1, "Field1", 2, "") }, CodeMinValue, map[string]interface{}{"min": 2}},
+		{"MaxValue", func(vc *ValidationContext) { vc.ValidateMaxValue(3, "Field1", 2, "") }, CodeMaxValue, map[string]interface{}{"max": 2}},
+		{"Date", func(vc *ValidationContext) { vc.ValidateDate("2023-13-01", "Field1", "") }, CodeDate, map[string]interface{}{"layout": "2006-01-02"}},
+		{"UUID", func(vc *ValidationContext) { vc.ValidateUUID("invalid", "Field1", "") }, CodeUUID, nil},
+		{"FileExtension", func(vc *ValidationContext) { vc.ValidateFileExtension(tmpFile, "Field1", []string{".png"}, "") }, CodeFileExtension, map[string]interface{}{"extensions": []string{".png"}}},
+		{"FileSize", func(vc *ValidationContext) { vc.ValidateFileSize(tmpFile, "Field1", 5, "") }, CodeFileSize, map[string]interface{}{"max": int64(5), "max_mb": int64(0), "size": int64(10)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc := NewValidationContext()
+			tt.validate(vc)
+			if len(vc.Errors()) != 1 {
+				t.Fatalf("Expected error count: 1, got: %v", len(vc.Errors()))
+			}
+			got := vc.Errors()[0]
+			if got.Code != tt.wantCode {
+				t.Errorf("Expected code: %v, got: %v", tt.wantCode, got.Code)
+			}
+			if !reflect.DeepEqual(got.Params, tt.wantParams) {
+				t.Errorf("Expected params: %v, got: %v", tt.wantParams, got.Params)
+			}
+		})
+	}
+}
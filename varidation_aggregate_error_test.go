@@ -1,6 +1,7 @@
 package validationcontext
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -42,3 +43,50 @@ func TestValidationAggregateError_GetStackTraces(t *testing.T) {
 		t.Errorf("Unexpected stack traces: %v", stackTraces)
 	}
 }
+
+func TestValidationAggregateError_ErrorsIsAs(t *testing.T) {
+	vc := NewValidationContext()
+	vc.Required("", "Name", "", false)
+	vc.ValidateEmail("invalid", "Email", "")
+	vc.AddErrorCode("EmployeeCode", "employee_code", "Invalid employee code", nil)
+	err := vc.AggregateError()
+
+	tests := []struct {
+		name   string
+		target error
+		want   bool
+	}{
+		{"Required", ErrRequired, true},
+		{"InvalidEmail", ErrInvalidEmail, true},
+		{"CustomCode", &RuleError{Code: "employee_code"}, true},
+		{"MinLength", ErrMinLength, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatal("Expected errors.As to find a *ValidationError")
+	}
+	if validationErr.Field != "Name" || validationErr.Code != CodeRequired {
+		t.Errorf("Unexpected error: %v", validationErr)
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	var err error = ValidationError{Field: "Field1", Code: CodeRequired, Message: "Error1"}
+
+	expected := "Field: Field1, Error: Error1"
+	if err.Error() != expected {
+		t.Errorf("Expected: %s, got: %s", expected, err.Error())
+	}
+	if !errors.Is(err, ErrRequired) {
+		t.Error("Expected the error to match ErrRequired")
+	}
+}